@@ -0,0 +1,282 @@
+package cmd
+
+import (
+	"bytes"
+	"cli-github-issues/internal/github"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var apiCmd = &cobra.Command{
+	Use:   "api <path>",
+	Short: "Make an authenticated GitHub API request",
+	Long: `Make an authenticated request to a GitHub REST API path and print the response.
+
+The {owner} and {repo} placeholders in the path are replaced with the configured
+repository. Fields are sent as query parameters for GET requests and as a JSON
+body otherwise; passing fields without -X switches the method to POST:
+
+  api repos/{owner}/{repo}/issues/1/comments -f body='Thanks!'
+  api repos/{owner}/{repo}/labels --paginate`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// set optional flags
+		method := flagMustExist(cmd.Flags().GetString("method"))
+		headers := flagMustExist(cmd.Flags().GetStringArray("header"))
+		paginate := flagMustExist(cmd.Flags().GetBool("paginate"))
+
+		// collect fields
+		fields, err := apiFields(cmd)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !cmd.Flags().Changed("method") && len(fields) > 0 {
+			method = http.MethodPost
+		}
+		method = strings.ToUpper(method)
+
+		// prepare first request url
+		urlStr := expandPlaceholders(strings.TrimPrefix(args[0], "/"))
+		var body any
+		if len(fields) > 0 {
+			if method == http.MethodGet {
+				urlStr = withQuery(urlStr, fields)
+			} else {
+				body = fields
+			}
+		}
+
+		// do requests, following the Link header when paginating
+		var pages []json.RawMessage
+		for urlStr != "" {
+			request, err := client.NewRequestWithContext(cmd.Context(), method, urlStr, body)
+			if err != nil {
+				log.Fatal(err)
+			}
+			for _, h := range headers {
+				key, value, ok := strings.Cut(h, ":")
+				if !ok {
+					log.Fatalf("Invalid header %q: expected key:value", h)
+				}
+				request.Header.Set(strings.TrimSpace(key), strings.TrimSpace(value))
+			}
+
+			// read the body as is, it may have a media type other than JSON
+			var data bytes.Buffer
+			resp, err := client.Do(request, &data)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if resp.StatusCode >= http.StatusBadRequest {
+				_ = printJSON(os.Stdout, data.Bytes())
+				log.Fatalf("Invalid status code: %d", resp.StatusCode)
+			}
+			if data.Len() > 0 {
+				pages = append(pages, data.Bytes())
+			}
+
+			urlStr = ""
+			if paginate {
+				urlStr = github.NextPageURL(resp)
+			}
+		}
+
+		// print result
+		for _, page := range mergeArrayPages(pages) {
+			if err := printJSON(os.Stdout, page); err != nil {
+				log.Fatal(err)
+			}
+		}
+	},
+}
+
+var apiGraphQLCmd = &cobra.Command{
+	Use:   "graphql",
+	Short: "Run a GraphQL query",
+	Long: `Run a GraphQL query or mutation and print the response data.
+
+The query is passed as the "query" field, every other field becomes a variable:
+
+  api graphql -f query='query($owner: String!) { repositoryOwner(login: $owner) { id } }' -f owner=octocat
+
+With --paginate the query must declare "$endCursor: String" and select
+"pageInfo { hasNextPage endCursor }"; the data of every page is printed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// set optional flags
+		paginate := flagMustExist(cmd.Flags().GetBool("paginate"))
+
+		// collect fields
+		fields, err := apiFields(cmd)
+		if err != nil {
+			log.Fatal(err)
+		}
+		query, ok := fields["query"].(string)
+		if !ok || query == "" {
+			log.Fatal("query field is required")
+		}
+		delete(fields, "query")
+
+		// do graphql requests
+		if paginate {
+			err = client.GraphQLPaginate(cmd.Context(), query, fields, func(data json.RawMessage) error {
+				return printJSON(os.Stdout, data)
+			})
+		} else {
+			var data json.RawMessage
+			if _, err = client.GraphQL(cmd.Context(), query, fields, &data); len(data) > 0 && string(data) != "null" {
+				if err := printJSON(os.Stdout, data); err != nil {
+					log.Fatal(err)
+				}
+			}
+		}
+
+		// check result
+		var gqlErrs github.GraphQLErrors
+		if errors.As(err, &gqlErrs) {
+			for _, e := range gqlErrs {
+				fmt.Fprintln(os.Stderr, e.Error())
+			}
+			os.Exit(1)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(apiCmd)
+	apiCmd.AddCommand(apiGraphQLCmd)
+
+	// set optional flags
+	apiCmd.Flags().StringP("method", "X", http.MethodGet, "HTTP method of the request")
+	apiCmd.Flags().StringArrayP("raw-field", "f", nil, "add a string field in key=value format")
+	apiCmd.Flags().StringArrayP("field", "F", nil, "add a typed field in key=value format; @file reads the value from a file")
+	apiCmd.Flags().StringArrayP("header", "H", nil, "add a request header in key:value format")
+	apiCmd.Flags().Bool("paginate", false, "follow the Link header and concatenate array responses")
+
+	apiGraphQLCmd.Flags().StringArrayP("raw-field", "f", nil, "add a string field in key=value format")
+	apiGraphQLCmd.Flags().StringArrayP("field", "F", nil, "add a typed field in key=value format; @file reads the value from a file")
+	apiGraphQLCmd.Flags().Bool("paginate", false, "fetch every page of the connection selected with pageInfo")
+}
+
+// apiFields collects the -f raw string fields and the -F typed fields.
+func apiFields(cmd *cobra.Command) (map[string]any, error) {
+	fields := make(map[string]any)
+
+	for _, f := range flagMustExist(cmd.Flags().GetStringArray("raw-field")) {
+		key, value, ok := strings.Cut(f, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid field %q: expected key=value", f)
+		}
+		fields[key] = value
+	}
+
+	for _, f := range flagMustExist(cmd.Flags().GetStringArray("field")) {
+		key, value, ok := strings.Cut(f, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid field %q: expected key=value", f)
+		}
+		v, err := typedFieldValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", key, err)
+		}
+		fields[key] = v
+	}
+
+	return fields, nil
+}
+
+// typedFieldValue converts a -F value: true, false and null become JSON
+// literals, integers become numbers and @file (or @- for stdin) is replaced
+// by the file contents.
+func typedFieldValue(value string) (any, error) {
+	switch {
+	case value == "true":
+		return true, nil
+	case value == "false":
+		return false, nil
+	case value == "null":
+		return nil, nil
+	case strings.HasPrefix(value, "@"):
+		var b []byte
+		var err error
+		if name := value[1:]; name == "-" {
+			b, err = io.ReadAll(os.Stdin)
+		} else {
+			b, err = os.ReadFile(name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	}
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n, nil
+	}
+	return value, nil
+}
+
+// expandPlaceholders replaces {owner} and {repo} with the configured repository.
+func expandPlaceholders(path string) string {
+	return strings.NewReplacer("{owner}", cfg.Owner, "{repo}", cfg.Repo).Replace(path)
+}
+
+// withQuery appends fields to the query string of urlStr.
+func withQuery(urlStr string, fields map[string]any) string {
+	q := url.Values{}
+	for k, v := range fields {
+		if v == nil {
+			continue
+		}
+		q.Add(k, fmt.Sprint(v))
+	}
+	sep := "?"
+	if strings.Contains(urlStr, "?") {
+		sep = "&"
+	}
+	return urlStr + sep + q.Encode()
+}
+
+// mergeArrayPages concatenates pages into a single array when every page is
+// a JSON array and returns them unchanged otherwise.
+func mergeArrayPages(pages []json.RawMessage) []json.RawMessage {
+	if len(pages) < 2 {
+		return pages
+	}
+	var merged []json.RawMessage
+	for _, page := range pages {
+		var items []json.RawMessage
+		if err := json.Unmarshal(page, &items); err != nil {
+			return pages
+		}
+		merged = append(merged, items...)
+	}
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return pages
+	}
+	return []json.RawMessage{b}
+}
+
+// printJSON writes data indented, or as is when it is not valid JSON.
+func printJSON(w io.Writer, data []byte) error {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		_, err = w.Write(data)
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err := buf.WriteTo(w)
+	return err
+}
@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"bufio"
+	"cli-github-issues/internal/browser"
+	"cli-github-issues/internal/config"
+	"cli-github-issues/internal/github"
+	"cli-github-issues/internal/oauth"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// annotationScopes is the command annotation listing, comma separated,
+	// the OAuth scopes the command needs.
+	annotationScopes = "scopes"
+
+	defaultExpiryWarningDays = 7
+)
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Authenticate with GitHub",
+}
+
+var authLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Store a GitHub token",
+	Long: `Store a GitHub token in the configured credential store.
+
+With --web the token is obtained through the OAuth device flow: a one-time
+code is printed and the verification page is opened in the browser. Without
+it a personal access token is read from stdin.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// set optional flags
+		web := flagMustExist(cmd.Flags().GetBool("web"))
+		clientID := flagMustExist(cmd.Flags().GetString("client-id"))
+		scopes := flagMustExist(cmd.Flags().GetStringSlice("scopes"))
+		if !cmd.Flags().Changed("client-id") && cfg.Auth.ClientID != "" {
+			clientID = cfg.Auth.ClientID
+		}
+		if !cmd.Flags().Changed("scopes") && len(cfg.Auth.Scopes) > 0 {
+			scopes = cfg.Auth.Scopes
+		}
+
+		store, err := config.NewCredentialStore(cfg.Auth.CredentialStore)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// obtain token
+		var token string
+		if web {
+			if clientID == "" {
+				log.Fatal("OAuth client ID is required: set auth.client_id or --client-id")
+			}
+			token = mustDeviceFlowToken(cmd, oauth.NewDeviceFlow(&http.Client{}, clientID, scopes))
+		} else {
+			fmt.Fprint(os.Stderr, "Paste your token: ")
+			line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+			if err != nil && line == "" {
+				log.Fatal(err)
+			}
+			token = strings.TrimSpace(line)
+		}
+		if token == "" {
+			log.Fatal("empty token")
+		}
+
+		// store token
+		if err := store.SetToken(token); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("Logged in, token stored in %s\n", store)
+	},
+}
+
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the authenticated user, token scopes and expiration",
+	Run: func(cmd *cobra.Command, args []string) {
+		// do get authenticated user request
+		user, resp, err := client.Users.Get(cmd.Context(), "")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+
+		// print result
+		info := github.ParseTokenInfo(resp)
+		fmt.Printf("Logged in as %s\n", user.GetLogin())
+		if info.HasScopes() {
+			fmt.Printf("Token scopes: %s\n", strings.Join(info.Scopes, ", "))
+		} else {
+			fmt.Println("Token scopes: not reported (fine-grained or app token)")
+		}
+		if info.ExpiresAt.IsZero() {
+			fmt.Println("Token expires: never")
+		} else {
+			fmt.Printf("Token expires: %s\n", formatTime(info.ExpiresAt))
+		}
+		if rate, ok := github.ParseRate(resp); ok {
+			fmt.Printf("Rate limit: %d/%d, resets %s\n", rate.Remaining, rate.Limit, formatTime(rate.Reset))
+		}
+		warnToken(info, nil)
+	},
+}
+
+// localCommands are the commands, with their subcommands, that do not send
+// requests with the token, or check it themselves as auth does.
+var localCommands = []string{"auth", "browse", "cache", "completion", "help", "queue"}
+
+// checkTokenScopes warns before running cmd, and so before any of its write
+// requests, when the token expires soon or lacks a scope listed in the scopes
+// annotation of cmd.
+func checkTokenScopes(cmd *cobra.Command, args []string) {
+	if client == nil || !cmd.Runnable() {
+		return
+	}
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		if slices.Contains(localCommands, c.Name()) {
+			return
+		}
+	}
+	// offline commands send no requests, queued changes are checked when
+	// sync push sends them
+	if offline, _ := cmd.Flags().GetBool("offline"); offline {
+		return
+	}
+
+	_, resp, err := client.Users.Get(cmd.Context(), "")
+	if err != nil || resp.StatusCode != http.StatusOK {
+		// leave reporting failures to the command itself
+		return
+	}
+	var want []string
+	if scopes, ok := cmd.Annotations[annotationScopes]; ok {
+		want = strings.Split(scopes, ",")
+	}
+	warnToken(github.ParseTokenInfo(resp), want)
+}
+
+// warnToken prints warnings about missing scopes out of want and about a
+// token expiring within the configured number of days.
+func warnToken(info github.TokenInfo, want []string) {
+	if info.HasScopes() {
+		if missing := info.MissingScopes(want...); len(missing) > 0 {
+			fmt.Fprintf(os.Stderr, "warning: token is missing the %s scope(s) this command needs\n", strings.Join(missing, ", "))
+		}
+	}
+
+	days := cfg.Auth.ExpiryWarningDays
+	if days <= 0 {
+		days = defaultExpiryWarningDays
+	}
+	if !info.ExpiresAt.IsZero() && time.Until(info.ExpiresAt) < time.Duration(days)*24*time.Hour {
+		fmt.Fprintf(os.Stderr, "warning: token expires %s\n", formatTime(info.ExpiresAt))
+	}
+}
+
+// mustDeviceFlowToken runs the device flow, printing the user code and
+// opening the verification page.
+func mustDeviceFlowToken(cmd *cobra.Command, flow *oauth.DeviceFlow) string {
+	code, err := flow.RequestCode(cmd.Context())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Fprintf(os.Stderr, "First copy your one-time code: %s\n", code.UserCode)
+	fmt.Fprintf(os.Stderr, "Then open %s in your browser and enter it.\n", code.VerificationURI)
+	if b, err := browser.NewBrowser(); err == nil {
+		_ = b.Open(code.VerificationURI)
+	}
+
+	token, err := flow.PollToken(cmd.Context(), code)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return token.AccessToken
+}
+
+func init() {
+	rootCmd.AddCommand(authCmd)
+	authCmd.AddCommand(authLoginCmd)
+	authCmd.AddCommand(authStatusCmd)
+
+	// set optional flags
+	authLoginCmd.Flags().Bool("web", false, "log in through the OAuth device flow in the browser")
+	authLoginCmd.Flags().String("client-id", "", "client ID of the OAuth app, overriding auth.client_id")
+	authLoginCmd.Flags().StringSlice("scopes", []string{"repo", "read:org"}, "OAuth scopes to request, overriding auth.scopes")
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/browser"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const webBaseURL = "https://github.com/"
+
+var browseCmd = &cobra.Command{
+	Use:   "browse",
+	Short: "Open an issue, the issue list or a search in the web browser",
+	Long: `Open an issue, the issue list or a search in the web browser.
+
+Without flags the issue list of the repository is opened. --search accepts
+either a raw query or the name of a search saved under "searches" in config.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// get browse params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		isNew := flagMustExist(cmd.Flags().GetBool("new"))
+		search := flagMustExist(cmd.Flags().GetString("search"))
+		printOnly := flagMustExist(cmd.Flags().GetBool("print"))
+
+		// build target url
+		var target string
+		switch {
+		case number != 0:
+			target = issueWebURL(number)
+		case isNew:
+			target = newIssueWebURL(
+				flagMustExist(cmd.Flags().GetString("title")),
+				flagMustExist(cmd.Flags().GetString("body")),
+				flagMustExist(cmd.Flags().GetStringSlice("label")),
+			)
+		case search != "":
+			if saved, ok := cfg.Searches[search]; ok {
+				search = saved
+			}
+			target = repoWebURL("issues") + "?" + url.Values{"q": {search}}.Encode()
+		default:
+			target = repoWebURL("issues")
+		}
+
+		// print or open result
+		if printOnly {
+			fmt.Println(target)
+			return
+		}
+		b, err := browser.NewBrowser()
+		if err != nil {
+			log.Fatalf("%s, use --print to output the URL instead", err)
+		}
+		if err := b.Open(target); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+// repoWebURL returns the web URL of a page under the configured repository.
+func repoWebURL(elem ...string) string {
+	return webBaseURL + strings.Join(append([]string{cfg.Owner, cfg.Repo}, elem...), "/")
+}
+
+// issueWebURL returns the HTML URL of the issue as reported by the API.
+func issueWebURL(number int) string {
+	return mustGetIssue(number).GetHTMLURL()
+}
+
+// newIssueWebURL returns the new issue page prefilled with the given fields.
+func newIssueWebURL(title string, body string, labels []string) string {
+	q := url.Values{}
+	if title != "" {
+		q.Set("title", title)
+	}
+	if body != "" {
+		q.Set("body", body)
+	}
+	if len(labels) > 0 {
+		q.Set("labels", strings.Join(labels, ","))
+	}
+
+	target := repoWebURL("issues", "new")
+	if len(q) > 0 {
+		target += "?" + q.Encode()
+	}
+	return target
+}
+
+func init() {
+	rootCmd.AddCommand(browseCmd)
+
+	// set optional flags
+	browseCmd.Flags().Int("number", 0, "issue number")
+	browseCmd.Flags().Bool("new", false, "open the new issue page")
+	browseCmd.Flags().String("title", "", "title to prefill with --new")
+	browseCmd.Flags().String("body", "", "body to prefill with --new")
+	browseCmd.Flags().StringSlice("label", nil, "labels to prefill with --new")
+	browseCmd.Flags().String("search", "", "issue search query or saved search name")
+	browseCmd.Flags().Bool("print", false, "print the URL instead of opening it")
+	browseCmd.MarkFlagsMutuallyExclusive("number", "new", "search")
+}
@@ -0,0 +1,443 @@
+package cmd
+
+import (
+	"bufio"
+	"cli-github-issues/internal/github"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// bulkMaxAttempts is how often a rate limited request is tried.
+	bulkMaxAttempts = 3
+
+	// bulkRateReserve is the number of remaining requests at which workers
+	// pause until the rate limit resets.
+	bulkRateReserve = 10
+)
+
+var bulkCmd = &cobra.Command{
+	Use:   "bulk [numbers...]",
+	Short: "Apply the same edits to many issues",
+	Long: `Apply the same edits to many issues.
+
+Issues are given as numbers and ranges such as "12 100-140" of the configured
+repository, as references such as "owner/repo#12" of other repositories, as
+"-" to read them from stdin, with --search to select every issue of the
+configured repositories (or of --org) matching a query, or with the list
+filters --label, --state, --assignee and --milestone to select every issue
+of the configured repositories they match. Edits run concurrently and
+continue past failures; a summary of every issue is printed at the end.`,
+	Example: `  cli-github-issues bulk 100-140 --close --reason not_planned
+  cli-github-issues bulk --search "label:stale" --add-label wontfix --close
+  cli-github-issues bulk --label needs-triage --assignee none --assign octocat
+  cat numbers.txt | cli-github-issues bulk - --assign octocat`,
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get edits from cli
+		edits := bulkEdits{
+			reason:       flagMustExist(cmd.Flags().GetString("reason")),
+			comment:      flagMustExist(cmd.Flags().GetString("comment")),
+			addLabels:    flagMustExist(cmd.Flags().GetStringSlice("add-label")),
+			removeLabels: flagMustExist(cmd.Flags().GetStringSlice("remove-label")),
+			assign:       flagMustExist(cmd.Flags().GetStringSlice("assign")),
+			unassign:     flagMustExist(cmd.Flags().GetStringSlice("unassign")),
+			milestone:    flagMustExist(cmd.Flags().GetString("set-milestone")),
+		}
+		switch {
+		case flagMustExist(cmd.Flags().GetBool("close")):
+			edits.state = "closed"
+		case flagMustExist(cmd.Flags().GetBool("reopen")):
+			edits.state = "open"
+		}
+		if err := edits.validate(); err != nil {
+			log.Fatal(err)
+		}
+
+		// collect issues
+		targets, err := parseIssueTargets(args, os.Stdin)
+		if err != nil {
+			log.Fatal(err)
+		}
+		seen := make(map[issueTarget]bool, len(targets))
+		for _, t := range targets {
+			seen[t] = true
+		}
+		add := func(repo repoRef, issue *github.Issue) {
+			if t := (issueTarget{repo, issue.GetNumber()}); !seen[t] {
+				seen[t] = true
+				targets = append(targets, t)
+			}
+		}
+		if query := flagMustExist(cmd.Flags().GetString("search")); query != "" {
+			scope := repoQualifiers(configuredRepos())
+			if org := flagMustExist(cmd.Flags().GetString("org")); org != "" {
+				scope = []string{github.Qualifier("org", org)}
+			}
+			query = fmt.Sprintf("%s %s is:issue", query, strings.Join(scope, " "))
+			opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}}
+			issues, _ := mustSearchIssues(cmd.Context(), query, opts, maxSearchResults)
+			for _, issue := range issues {
+				owner, repo, err := parseRepo(issue.RepositoryFullName())
+				if err != nil {
+					log.Fatal(err)
+				}
+				add(repoRef{owner, repo}, issue)
+			}
+		}
+		if filters := bulkListFilters(cmd); filters != nil {
+			for _, repo := range configuredRepos() {
+				issues, err := listRepoIssues(cmd.Context(), repo, *filters, math.MaxInt)
+				if err != nil {
+					log.Fatalf("%s: %s", repo, err)
+				}
+				for _, issue := range issues {
+					add(repo, issue)
+				}
+			}
+		}
+		if len(targets) == 0 {
+			log.Fatal("no issues selected")
+		}
+
+		// apply edits with a bounded worker pool
+		workers := max(flagMustExist(cmd.Flags().GetInt("workers")), 1)
+		errs := runBulk(cmd.Context(), targets, workers, edits)
+
+		// print summary
+		failed := 0
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for i, t := range targets {
+			if errs[i] != nil {
+				failed++
+				fmt.Fprintf(w, "%s\tfailed\t%v\n", t, errs[i])
+			} else {
+				fmt.Fprintf(w, "%s\tok\t\n", t)
+			}
+		}
+		w.Flush()
+		fmt.Printf("\n%d succeeded, %d failed\n", len(targets)-failed, failed)
+		if failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+// issueTarget is an issue selected for bulk edits.
+type issueTarget struct {
+	repo   repoRef
+	number int
+}
+
+func (t issueTarget) String() string {
+	return issueRef(t.repo.owner, t.repo.name, t.number)
+}
+
+// bulkEdits are the changes applied to every selected issue.
+type bulkEdits struct {
+	state        string
+	reason       string
+	comment      string
+	addLabels    []string
+	removeLabels []string
+	assign       []string
+	unassign     []string
+	milestone    string
+}
+
+func (e bulkEdits) validate() error {
+	if e.state == "" && e.comment == "" && e.milestone == "" &&
+		len(e.addLabels)+len(e.removeLabels)+len(e.assign)+len(e.unassign) == 0 {
+		return errors.New("no edits given")
+	}
+	if e.reason != "" && e.state != "closed" {
+		return errors.New("--reason requires --close")
+	}
+	if e.reason != "" && !slices.Contains(closeReasons, e.reason) {
+		return fmt.Errorf("invalid reason %q, expected one of %v", e.reason, closeReasons)
+	}
+	if _, err := strconv.Atoi(e.milestone); e.milestone != "" && e.milestone != "none" && err != nil {
+		return fmt.Errorf("invalid milestone %q, expected a number or none", e.milestone)
+	}
+	return nil
+}
+
+// apply applies the edits to one issue, stopping at its first failure.
+func (e bulkEdits) apply(ctx context.Context, gate *rateGate, t issueTarget) error {
+	owner, repo, number := t.repo.owner, t.repo.name, t.number
+	var steps []func() (*http.Response, error)
+	if e.comment != "" {
+		steps = append(steps, func() (*http.Response, error) {
+			_, resp, err := client.Issues.CreateComment(owner, repo, number, &github.IssueComment{Body: github.String(e.comment)})
+			return resp, err
+		})
+	}
+	if len(e.addLabels) > 0 {
+		steps = append(steps, func() (*http.Response, error) {
+			_, resp, err := client.Issues.AddLabelsToIssue(ctx, owner, repo, number, e.addLabels)
+			return resp, err
+		})
+	}
+	for _, label := range e.removeLabels {
+		label := label
+		steps = append(steps, func() (*http.Response, error) {
+			return client.Issues.RemoveLabelForIssue(ctx, owner, repo, number, label)
+		})
+	}
+	if len(e.assign) > 0 {
+		steps = append(steps, func() (*http.Response, error) {
+			_, resp, err := client.Issues.AddAssignees(ctx, owner, repo, number, e.assign)
+			return resp, err
+		})
+	}
+	if len(e.unassign) > 0 {
+		steps = append(steps, func() (*http.Response, error) {
+			_, resp, err := client.Issues.RemoveAssignees(ctx, owner, repo, number, e.unassign)
+			return resp, err
+		})
+	}
+	if e.milestone == "none" {
+		steps = append(steps, func() (*http.Response, error) {
+			_, resp, err := client.Issues.RemoveMilestone(ctx, owner, repo, number)
+			return resp, err
+		})
+	}
+	if req := e.issueRequest(); req != nil {
+		steps = append(steps, func() (*http.Response, error) {
+			_, resp, err := client.Issues.Update(owner, repo, number, req)
+			return resp, err
+		})
+	}
+
+	for _, step := range steps {
+		if err := gate.do(ctx, step); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// issueRequest returns the state and milestone changes, or nil if there are none.
+func (e bulkEdits) issueRequest() *github.IssueRequest {
+	req := &github.IssueRequest{}
+	if e.state != "" {
+		req.State = github.String(e.state)
+	}
+	if e.reason != "" {
+		req.StateReason = github.String(e.reason)
+	}
+	if m, err := strconv.Atoi(e.milestone); err == nil {
+		req.Milestone = github.Int(m)
+	}
+	if req.State == nil && req.Milestone == nil {
+		return nil
+	}
+	return req
+}
+
+// runBulk applies edits to targets using up to workers goroutines and returns
+// the error of each issue, in the order of targets.
+func runBulk(ctx context.Context, targets []issueTarget, workers int, edits bulkEdits) []error {
+	var (
+		errs = make([]error, len(targets))
+		jobs = make(chan int)
+		gate = &rateGate{}
+		wg   sync.WaitGroup
+	)
+	for w := 0; w < min(workers, len(targets)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				errs[i] = edits.apply(ctx, gate, targets[i])
+			}
+		}()
+	}
+	for i := range targets {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errs
+}
+
+// rateGate pauses all workers when the rate limit is about to run out or a
+// request was rate limited.
+type rateGate struct {
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+// do runs fn once the gate is open, retrying it when it was rate limited.
+func (g *rateGate) do(ctx context.Context, fn func() (*http.Response, error)) error {
+	for attempt := 1; ; attempt++ {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+
+		resp, err := fn()
+		if d := github.RetryAfter(resp); d > 0 && attempt < bulkMaxAttempts {
+			g.pause(d)
+			continue
+		}
+		if rate, ok := github.ParseRate(resp); ok && rate.Remaining <= bulkRateReserve {
+			g.pause(time.Until(rate.Reset))
+		}
+
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode >= http.StatusMultipleChoices {
+			return fmt.Errorf("invalid status code: %d", resp.StatusCode)
+		}
+		return nil
+	}
+}
+
+func (g *rateGate) pause(d time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(g.pausedUntil) {
+		fmt.Fprintf(os.Stderr, "rate limited, waiting until %s\n", until.Format(time.TimeOnly))
+		g.pausedUntil = until
+	}
+}
+
+func (g *rateGate) wait(ctx context.Context) error {
+	g.mu.Lock()
+	d := time.Until(g.pausedUntil)
+	g.mu.Unlock()
+
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// bulkListFilters returns the list options of the --label, --state,
+// --assignee and --milestone flags, or nil if none of them is set.
+func bulkListFilters(cmd *cobra.Command) *github.IssueListByRepoOptions {
+	flags := cmd.Flags()
+	if !flags.Changed("label") && !flags.Changed("state") && !flags.Changed("assignee") && !flags.Changed("milestone") {
+		return nil
+	}
+	return &github.IssueListByRepoOptions{
+		State:     flagMustExist(flags.GetString("state")),
+		Labels:    flagMustExist(flags.GetStringSlice("label")),
+		Assignee:  flagMustExist(flags.GetString("assignee")),
+		Milestone: flagMustExist(flags.GetString("milestone")),
+	}
+}
+
+// parseIssueTargets parses numbers and ranges like "12", "#12" and "100-140"
+// of the configured repository, optionally prefixed with a repository as in
+// "owner/repo#12". An argument "-" reads further whitespace separated
+// targets from stdin.
+func parseIssueTargets(args []string, stdin io.Reader) ([]issueTarget, error) {
+	var targets []issueTarget
+	seen := map[issueTarget]bool{}
+	add := func(token string) error {
+		repo := repoRef{cfg.Owner, cfg.Repo}
+		numbers := token
+		if name, n, ok := strings.Cut(token, "#"); ok && name != "" {
+			var err error
+			if repo, err = parseRepoRef(name); err != nil {
+				return err
+			}
+			numbers = n
+		}
+		numbers = strings.TrimPrefix(numbers, "#")
+		from, to, isRange := strings.Cut(numbers, "-")
+		first, err := strconv.Atoi(from)
+		if err != nil || first <= 0 {
+			return fmt.Errorf("invalid issue number %q", token)
+		}
+		last := first
+		if isRange {
+			if last, err = strconv.Atoi(to); err != nil {
+				return fmt.Errorf("invalid issue range %q", token)
+			}
+			if last < first {
+				return fmt.Errorf("invalid issue range %q: %d is before %d", token, last, first)
+			}
+			if last-first >= maxSearchResults {
+				return fmt.Errorf("invalid issue range %q: it spans more than %d issues", token, maxSearchResults)
+			}
+		}
+		for n := first; n <= last; n++ {
+			if t := (issueTarget{repo, n}); !seen[t] {
+				seen[t] = true
+				targets = append(targets, t)
+			}
+		}
+		return nil
+	}
+
+	for _, arg := range args {
+		if arg != "-" {
+			if err := add(arg); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// read numbers from stdin
+		scanner := bufio.NewScanner(stdin)
+		scanner.Split(bufio.ScanWords)
+		for scanner.Scan() {
+			if err := add(scanner.Text()); err != nil {
+				return nil, err
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return targets, nil
+}
+
+func init() {
+	rootCmd.AddCommand(bulkCmd)
+
+	// set selection flags
+	bulkCmd.Flags().String("search", "", "search query selecting the issues to edit")
+	bulkCmd.Flags().String("org", "", "run --search across the repositories of an organization")
+	bulkCmd.Flags().StringSlice("label", nil, "select the issues with these labels")
+	bulkCmd.Flags().String("state", "open", "select the issues in this state: open, closed or all")
+	bulkCmd.Flags().String("assignee", "", "select the issues of this assignee, \"none\" or \"*\"")
+	bulkCmd.Flags().String("milestone", "", "select the issues of this milestone number, \"none\" or \"*\"")
+	bulkCmd.Flags().Int("workers", 4, "number of issues edited concurrently")
+
+	// set edit flags
+	bulkCmd.Flags().Bool("close", false, "close the issues")
+	bulkCmd.Flags().Bool("reopen", false, "reopen the issues")
+	bulkCmd.Flags().String("reason", "", "reason for closing: completed, not_planned or duplicate")
+	bulkCmd.Flags().String("comment", "", "comment to add to every issue")
+	bulkCmd.Flags().StringSlice("add-label", nil, "labels to add")
+	bulkCmd.Flags().StringSlice("remove-label", nil, "labels to remove")
+	bulkCmd.Flags().StringSlice("assign", nil, "users to assign")
+	bulkCmd.Flags().StringSlice("unassign", nil, "users to unassign")
+	bulkCmd.Flags().String("set-milestone", "", "milestone number to set, or none to clear it")
+	bulkCmd.MarkFlagsMutuallyExclusive("close", "reopen")
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the HTTP response cache",
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove every cached response",
+	Run: func(cmd *cobra.Command, args []string) {
+		// clear cache directory
+		dir, err := github.DefaultCacheDir()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := github.ClearCache(dir); err != nil {
+			log.Fatal(err)
+		}
+
+		// print result
+		fmt.Printf("Cleared %s\n", dir)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+}
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var cloneIssueCmd = &cobra.Command{
+	Use:   "clone-issue <number>",
+	Short: "Copy an issue to another repository",
+	Long: `Copy an issue of the configured repository to another repository, such as a
+fork, leaving the original in place unlike transfer.
+
+The copy gets the title, body and labels of the issue; labels missing in the
+target repository are created with the same color and description. With
+--comments every comment is copied too, quoted with its author and time. A
+comment linking to the copy is added to the original issue.`,
+	Example:     `  cli-github-issues clone-issue 12 --to octocat/fork --comments`,
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get clone params from cli
+		number, err := strconv.Atoi(strings.TrimPrefix(args[0], "#"))
+		if err != nil {
+			log.Fatalf("Invalid issue number %q", args[0])
+		}
+		target, err := parseRepoRef(flagMustExist(cmd.Flags().GetString("to")))
+		if err != nil {
+			log.Fatal(err)
+		}
+		withComments := flagMustExist(cmd.Flags().GetBool("comments"))
+		source := repoRef{cfg.Owner, cfg.Repo}
+		sourceRef := fmt.Sprintf("%s#%d", source, number)
+
+		ctx := cmd.Context()
+		gate := &rateGate{}
+		pace := &throttle{interval: contentInterval}
+		if dryRun {
+			pace.interval = 0
+		}
+
+		// read the original before creating anything
+		issue := mustGetIssue(number)
+		var comments []*github.IssueComment
+		if withComments && issue.GetComments() > 0 {
+			comments = mustListComments(number)
+		}
+
+		// create the copy with labels as in the original
+		mustEnsureLabels(ctx, gate, pace, target, issue.Labels)
+		req := &github.IssueRequest{
+			Title: github.String(issue.GetTitle()),
+			Body:  github.String(clonedBody(issue, sourceRef)),
+		}
+		if names := issue.LabelNames(); len(names) > 0 {
+			req.Labels = &names
+		}
+		var clone *github.Issue
+		err = gate.do(ctx, func() (*http.Response, error) {
+			if err := pace.wait(ctx); err != nil {
+				return nil, err
+			}
+			var (
+				resp *http.Response
+				err  error
+			)
+			clone, resp, err = client.Issues.Create(target.owner, target.name, req)
+			return resp, err
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		cloneRef := fmt.Sprintf("%s#%d", target, clone.GetNumber())
+
+		// copy comments in their original order
+		for _, c := range comments {
+			if err := cloneComment(ctx, gate, pace, target, clone.GetNumber(), quoteComment(c)); err != nil {
+				log.Fatalf("%s: copied %s but not all its comments: %s", cloneRef, sourceRef, err)
+			}
+		}
+
+		// link the copy from the original
+		if err := cloneComment(ctx, gate, pace, source, number, "Copied to "+cloneRef); err != nil {
+			log.Fatalf("%s: copied %s but could not link it: %s", cloneRef, sourceRef, err)
+		}
+
+		// print result
+		if dryRun {
+			fmt.Printf("%s would be copied to %s\n", sourceRef, target)
+			return
+		}
+		fmt.Printf("Copied %s to %s with %d comments\n", sourceRef, cloneRef, len(comments))
+		fmt.Println(clone.GetHTMLURL())
+	},
+}
+
+// cloneComment adds a comment to the issue number of repo.
+func cloneComment(ctx context.Context, gate *rateGate, pace *throttle, repo repoRef, number int, body string) error {
+	return gate.do(ctx, func() (*http.Response, error) {
+		if err := pace.wait(ctx); err != nil {
+			return nil, err
+		}
+		_, resp, err := client.Issues.CreateComment(repo.owner, repo.name, number, &github.IssueComment{
+			Body: github.String(body),
+		})
+		return resp, err
+	})
+}
+
+// clonedBody returns the body of the copy of issue, noting the reference of
+// the original.
+func clonedBody(issue *github.Issue, ref string) string {
+	body := strings.TrimRight(issue.GetBody(), "\n")
+	if body != "" {
+		body += "\n\n"
+	}
+	return body + fmt.Sprintf("_Copied from %s_\n", ref)
+}
+
+// quoteComment returns c as a quote headed by its author and creation time.
+func quoteComment(c *github.IssueComment) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s** commented on %s UTC:\n\n", c.GetUser().GetLogin(), c.GetCreatedAt().UTC().Format(timeLayout))
+	for _, line := range strings.Split(strings.TrimRight(c.GetBody(), "\n"), "\n") {
+		b.WriteString(strings.TrimRight("> "+line, " ") + "\n")
+	}
+	return b.String()
+}
+
+func init() {
+	rootCmd.AddCommand(cloneIssueCmd)
+
+	// set required flag
+	cloneIssueCmd.Flags().String("to", "", "target repository as owner/repo")
+	cloneIssueCmd.MarkFlagRequired("to")
+
+	// set optional flags
+	cloneIssueCmd.Flags().Bool("comments", false, "copy the comments too")
+}
@@ -2,22 +2,65 @@ package cmd
 
 import (
 	"cli-github-issues/internal/github"
+	"cli-github-issues/internal/mirror"
 	"fmt"
 	"github.com/spf13/cobra"
 	"log"
 	"net/http"
+	"slices"
 )
 
+// closeReasons lists the state_reason values accepted by GitHub for closed issues.
+var closeReasons = []string{"completed", "not_planned", "duplicate"}
+
 var closeCmd = &cobra.Command{
-	Use:   "close",
-	Short: "Close an issue on the specified repository.",
+	Use:         "close",
+	Short:       "Close an issue on the specified repository.",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
 	Run: func(cmd *cobra.Command, args []string) {
-		// get issue number from cli
+		// get close params from cli
 		number := flagMustExist(cmd.Flags().GetInt("number"))
+		reason := flagMustExist(cmd.Flags().GetString("reason"))
+		duplicateOf := flagMustExist(cmd.Flags().GetInt("duplicate-of"))
+		comment := flagMustExist(cmd.Flags().GetString("comment"))
+		offline := flagMustExist(cmd.Flags().GetBool("offline"))
+
+		// a duplicate link always closes the issue as duplicate
+		if duplicateOf != 0 {
+			if cmd.Flags().Changed("reason") && reason != "duplicate" {
+				log.Fatalf("--duplicate-of cannot be used with --reason %s", reason)
+			}
+			reason = "duplicate"
+		}
+		if reason != "" && !slices.Contains(closeReasons, reason) {
+			log.Fatalf("Invalid reason %q, expected one of %v", reason, closeReasons)
+		}
+
+		// post closing remarks before the state change, as the web UI does
+		var remarks []string
+		if duplicateOf != 0 {
+			remarks = append(remarks, fmt.Sprintf("Duplicate of #%d", duplicateOf))
+		}
+		if comment != "" {
+			remarks = append(remarks, comment)
+		}
 
 		// close issue
 		editedIssue := &github.IssueRequest{
-			State: github.String("close"),
+			State: github.String("closed"),
+		}
+		if reason != "" {
+			editedIssue.StateReason = github.String(reason)
+		}
+		if offline {
+			for _, remark := range remarks {
+				mustEnqueue(&mirror.Change{Kind: mirror.ChangeComment, Number: number, Comment: remark})
+			}
+			mustEnqueue(&mirror.Change{Kind: mirror.ChangeClose, Number: number, Request: editedIssue})
+			return
+		}
+		for _, remark := range remarks {
+			mustCreateComment(number, remark)
 		}
 		issue, resp, err := client.Issues.Update(cfg.Owner, cfg.Repo, number, editedIssue)
 		if err != nil {
@@ -26,17 +69,36 @@ var closeCmd = &cobra.Command{
 
 		// check and print result
 		if resp.StatusCode == http.StatusOK {
-			fmt.Printf("#%-5d %9.9s %.55s %q\n", *issue.Number, *issue.User.Login, *issue.Title, *issue.Body)
+			printIssue(issue)
 		} else {
 			log.Fatalf("Invalid status code: %d", resp.StatusCode)
 		}
 	},
 }
 
+// mustCreateComment adds a comment to the issue and exits on failure.
+func mustCreateComment(number int, body string) {
+	_, resp, err := client.Issues.CreateComment(cfg.Owner, cfg.Repo, number, &github.IssueComment{
+		Body: github.String(body),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		log.Fatalf("Invalid status code: %d", resp.StatusCode)
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(closeCmd)
 
 	// set required flag
 	closeCmd.Flags().Int("number", 0, "issue number")
 	closeCmd.MarkFlagRequired("number")
+
+	// set optional flags
+	closeCmd.Flags().String("reason", "", "reason for closing: completed, not_planned or duplicate")
+	closeCmd.Flags().Int("duplicate-of", 0, "number of the issue this one duplicates")
+	closeCmd.Flags().String("comment", "", "comment to add when closing")
+	closeCmd.Flags().Bool("offline", false, "queue the comments and the close to be sent by sync push")
 }
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/editor"
+	"cli-github-issues/internal/mirror"
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+)
+
+var commentCmd = &cobra.Command{
+	Use:   "comment",
+	Short: "Manage the comments of an issue",
+}
+
+var commentAddCmd = &cobra.Command{
+	Use:         "add",
+	Short:       "Add a comment to an issue, written in the editor unless --body is set",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get comment params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		body := flagMustExist(cmd.Flags().GetString("body"))
+		if body == "" {
+			bodyData, err := editor.SaveInputDataToFile(cfg.Editor)
+			if err != nil {
+				return
+			}
+			body = string(bodyData)
+		}
+		if body == "" {
+			log.Fatal("Aborting comment with an empty body")
+		}
+
+		// add comment
+		if flagMustExist(cmd.Flags().GetBool("offline")) {
+			mustEnqueue(&mirror.Change{Kind: mirror.ChangeComment, Number: number, Comment: body})
+			return
+		}
+		mustCreateComment(number, body)
+		fmt.Printf("Commented on #%d\n", number)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(commentCmd)
+	commentCmd.AddCommand(commentAddCmd)
+
+	// set required flag
+	commentAddCmd.Flags().Int("number", 0, "issue number")
+	commentAddCmd.MarkFlagRequired("number")
+
+	// set optional flags
+	commentAddCmd.Flags().String("body", "", "comment text instead of opening the editor")
+	commentAddCmd.Flags().Bool("offline", false, "queue the comment to be sent by sync push")
+}
@@ -3,7 +3,7 @@ package cmd
 import (
 	"cli-github-issues/internal/editor"
 	"cli-github-issues/internal/github"
-	"fmt"
+	"cli-github-issues/internal/mirror"
 	"log"
 	"net/http"
 
@@ -12,8 +12,9 @@ import (
 
 // createCmd represents the create command
 var createCmd = &cobra.Command{
-	Use:   "create",
-	Short: "Create a new issue on the specified repository",
+	Use:         "create",
+	Short:       "Create a new issue on the specified repository",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
 	Run: func(cmd *cobra.Command, args []string) {
 		// save title of issue
 		title := flagMustExist(cmd.Flags().GetString("title"))
@@ -29,6 +30,10 @@ var createCmd = &cobra.Command{
 			Title: &title,
 			Body:  github.String(string(bodyData)),
 		}
+		if flagMustExist(cmd.Flags().GetBool("offline")) {
+			mustEnqueue(&mirror.Change{Kind: mirror.ChangeCreate, Request: req})
+			return
+		}
 		issue, resp, err := client.Issues.Create(cfg.Owner, cfg.Repo, req)
 		if err != nil {
 			log.Fatal(err)
@@ -36,7 +41,7 @@ var createCmd = &cobra.Command{
 
 		// check and print result
 		if resp.StatusCode == http.StatusCreated {
-			fmt.Printf("#%-5d %9.9s %.55s %q\n", *issue.Number, *issue.User.Login, *issue.Title, *issue.Body)
+			printIssue(issue)
 		} else {
 			log.Fatalf("Invalid status code: %d", resp.StatusCode)
 		}
@@ -49,4 +54,7 @@ func init() {
 	// set required flag
 	createCmd.Flags().String("title", "", "Issue title")
 	createCmd.MarkFlagRequired("title")
+
+	// set optional flags
+	createCmd.Flags().Bool("offline", false, "queue the issue to be created by sync push")
 }
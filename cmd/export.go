@@ -0,0 +1,271 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/export"
+	"cli-github-issues/internal/github"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// exportIncludes lists the related data that can be exported with issues.
+var exportIncludes = []string{"comments", "events", "reactions"}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the issues of the repository to JSON Lines, CSV or Markdown",
+	Long: `Export the issues of the repository in order of creation, streaming them to
+JSON Lines, CSV or a directory of Markdown files with front matter.
+
+Comments, events and reactions are exported with --include; CSV rows only hold
+the --columns of the issue itself. With --checkpoint, progress is recorded
+after every issue and an interrupted export started again with the same
+arguments resumes after the last issue it wrote.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// get export params from cli
+		format := flagMustExist(cmd.Flags().GetString("format"))
+		output := flagMustExist(cmd.Flags().GetString("output"))
+		columns := flagMustExist(cmd.Flags().GetStringSlice("columns"))
+		include := flagMustExist(cmd.Flags().GetStringSlice("include"))
+		state := flagMustExist(cmd.Flags().GetString("state"))
+		dateField := flagMustExist(cmd.Flags().GetString("date"))
+		since := mustParseDate(flagMustExist(cmd.Flags().GetString("since")))
+		until := mustParseDate(flagMustExist(cmd.Flags().GetString("until")))
+		checkpointPath := flagMustExist(cmd.Flags().GetString("checkpoint"))
+
+		if !slices.Contains(export.Formats, format) {
+			log.Fatalf("Invalid format %q, expected one of %v", format, export.Formats)
+		}
+		for _, name := range include {
+			if !slices.Contains(exportIncludes, name) {
+				log.Fatalf("Invalid include %q, expected one of %v", name, exportIncludes)
+			}
+		}
+		if dateField != "created" && dateField != "updated" {
+			log.Fatalf("Invalid date %q, expected created or updated", dateField)
+		}
+		if format == "markdown" && output == "" {
+			log.Fatal("--output is required for the markdown format")
+		}
+		if checkpointPath != "" && output == "" {
+			log.Fatal("--checkpoint requires --output")
+		}
+		if len(columns) == 0 {
+			columns = export.DefaultColumns
+		}
+
+		// resume from the checkpoint of the same export
+		cp := &export.Checkpoint{
+			Repo:   repoRef{cfg.Owner, cfg.Repo}.String(),
+			Format: format,
+			Output: output,
+			Options: fmt.Sprintf("state=%s date=%s since=%s until=%s columns=%s include=%s",
+				state, dateField, formatDate(since), formatDate(until), strings.Join(columns, ","), strings.Join(include, ",")),
+		}
+		if checkpointPath != "" {
+			prev, err := export.LoadCheckpoint(checkpointPath)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if prev != nil {
+				if !prev.Matches(cp) {
+					log.Fatalf("Checkpoint %s belongs to another export, remove it to start over", checkpointPath)
+				}
+				cp = prev
+				fmt.Fprintf(os.Stderr, "Resuming after #%d, %d issues exported so far\n", cp.LastNumber, cp.Exported)
+			}
+		}
+
+		file, w := mustOpenExport(format, output, columns, cp.Offset)
+		if file != nil {
+			defer file.Close()
+		}
+
+		// stream issues oldest first, so that the checkpoint is a position
+		opts := &github.IssueListByRepoOptions{
+			State:       state,
+			Sort:        "created",
+			Direction:   "asc",
+			ListOptions: github.ListOptions{PerPage: 100},
+		}
+		if dateField == "updated" {
+			opts.Since = since
+		}
+		for {
+			page, resp, err := client.Issues.ListByRepo(cmd.Context(), cfg.Owner, cfg.Repo, opts)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				log.Fatalf("Invalid status code: %d", resp.StatusCode)
+			}
+
+			for _, issue := range page {
+				if issue.IsPullRequest() || cp.Done(issue) {
+					continue
+				}
+				t := issue.GetCreatedAt()
+				if dateField == "updated" {
+					t = issue.GetUpdatedAt()
+				}
+				if !since.IsZero() && t.Before(since) {
+					continue
+				}
+				if !until.IsZero() && !t.Before(until.AddDate(0, 0, 1)) {
+					continue
+				}
+
+				// write issue, then record it
+				if err := w.Write(exportRecord(cmd, issue, include)); err != nil {
+					log.Fatal(err)
+				}
+				var offset int64
+				if file != nil {
+					if offset, err = file.Seek(0, io.SeekCurrent); err != nil {
+						log.Fatal(err)
+					}
+				}
+				cp.Advance(issue, offset)
+				if checkpointPath != "" {
+					if err := cp.Save(checkpointPath); err != nil {
+						log.Fatal(err)
+					}
+				}
+			}
+
+			// issues created after the range end it
+			last := len(page) - 1
+			if dateField == "created" && !until.IsZero() && last >= 0 && !page[last].GetCreatedAt().Before(until.AddDate(0, 0, 1)) {
+				break
+			}
+			if opts.Page = github.NextPage(resp); opts.Page == 0 {
+				break
+			}
+		}
+
+		// the export is complete, so there is nothing to resume
+		if checkpointPath != "" {
+			if err := os.Remove(checkpointPath); err != nil {
+				log.Fatal(err)
+			}
+		}
+		fmt.Fprintf(os.Stderr, "Exported %d issues\n", cp.Exported)
+	},
+}
+
+// mustOpenExport opens the export writer of format. Output files are
+// truncated to offset, the size after the last checkpointed issue; the
+// returned file is nil for standard output and the markdown directory.
+func mustOpenExport(format string, output string, columns []string, offset int64) (*os.File, export.Writer) {
+	if format == "markdown" {
+		w, err := export.NewMarkdownWriter(output)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return nil, w
+	}
+
+	var (
+		file *os.File
+		out  io.Writer = os.Stdout
+	)
+	if output != "" {
+		var err error
+		if file, err = os.OpenFile(output, os.O_RDWR|os.O_CREATE, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		if err := file.Truncate(offset); err != nil {
+			log.Fatal(err)
+		}
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			log.Fatal(err)
+		}
+		out = file
+	}
+
+	if format == "csv" {
+		w, err := export.NewCSVWriter(out, columns, offset == 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return file, w
+	}
+	return file, export.NewJSONLWriter(out)
+}
+
+// exportRecord fetches the related data named in include for issue.
+func exportRecord(cmd *cobra.Command, issue *github.Issue, include []string) *export.Record {
+	r := &export.Record{Issue: issue}
+	number := issue.GetNumber()
+	if slices.Contains(include, "comments") && issue.GetComments() > 0 {
+		r.Comments = mustListComments(number)
+	}
+	if slices.Contains(include, "events") {
+		r.Events = mustListIssueEvents(cmd, number)
+	}
+	if slices.Contains(include, "reactions") && issue.GetReactions().GetTotalCount() > 0 {
+		r.Reactions = mustListReactions(cmd, number, 0)
+	}
+	return r
+}
+
+// mustListIssueEvents returns every event of the issue, following pagination.
+func mustListIssueEvents(cmd *cobra.Command, number int) []*github.IssueEvent {
+	var all []*github.IssueEvent
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		events, resp, err := client.Issues.ListIssueEvents(cmd.Context(), cfg.Owner, cfg.Repo, number, opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+		all = append(all, events...)
+
+		if opts.Page = github.NextPage(resp); opts.Page == 0 {
+			return all
+		}
+	}
+}
+
+// mustParseDate parses a YYYY-MM-DD date in UTC; "" is the zero time.
+func mustParseDate(s string) time.Time {
+	if s == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		log.Fatalf("Invalid date %q, expected YYYY-MM-DD", s)
+	}
+	return t
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	// set optional flags
+	exportCmd.Flags().String("format", "jsonl", "output format: jsonl, csv or markdown")
+	exportCmd.Flags().StringP("output", "o", "", "output file, or directory for markdown; standard output if empty")
+	exportCmd.Flags().StringSlice("columns", nil, "CSV columns out of "+strings.Join(export.Columns, ", "))
+	exportCmd.Flags().StringSlice("include", nil, "related data to export: comments, events, reactions")
+	exportCmd.Flags().String("state", "all", "issue state: open, closed or all")
+	exportCmd.Flags().String("date", "created", "date the --since and --until range applies to: created or updated")
+	exportCmd.Flags().String("since", "", "only issues on or after this date (YYYY-MM-DD)")
+	exportCmd.Flags().String("until", "", "only issues on or before this date (YYYY-MM-DD)")
+	exportCmd.Flags().String("checkpoint", "", "file recording progress, to resume an interrupted export")
+}
@@ -1,7 +1,7 @@
 package cmd
 
 import (
-	"fmt"
+	"cli-github-issues/internal/github"
 	"github.com/spf13/cobra"
 	"log"
 	"net/http"
@@ -22,7 +22,7 @@ var getCmd = &cobra.Command{
 
 		// check and print result
 		if resp.StatusCode == http.StatusOK {
-			fmt.Printf("#%-5d %9.9s %.55s %q\n", *issue.Number, *issue.User.Login, *issue.Title, *issue.Body)
+			printIssue(issue)
 		} else {
 			log.Fatalf("Invalid status code: %d", resp.StatusCode)
 		}
@@ -36,3 +36,15 @@ func init() {
 	getCmd.Flags().Int("number", 0, "issue number")
 	getCmd.MarkFlagRequired("number")
 }
+
+// mustGetIssue returns the issue with the given number and exits on failure.
+func mustGetIssue(number int) *github.Issue {
+	issue, resp, err := client.Issues.Get(cfg.Owner, cfg.Repo, number)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("Invalid status code: %d", resp.StatusCode)
+	}
+	return issue
+}
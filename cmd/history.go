@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// historyEntry is a single line of the audit trail.
+type historyEntry struct {
+	at     time.Time
+	actor  string
+	action string
+}
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show the chronological audit trail of an issue",
+	Long: `Show the chronological audit trail of an issue: who opened, labeled,
+assigned, renamed, referenced or closed it and when.
+
+Without --number the recent issue events of the whole repository are shown.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// get history params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		limit := mustLimit(cmd)
+
+		// collect entries
+		var entries []historyEntry
+		if number != 0 {
+			issue := mustGetIssue(number)
+			entries = append(entries, historyEntry{issue.GetCreatedAt(), issue.GetUser().GetLogin(), "opened this issue"})
+			opts := &github.ListOptions{PerPage: 100}
+			for {
+				timeline, resp, err := client.Issues.ListIssueTimeline(cmd.Context(), cfg.Owner, cfg.Repo, number, opts)
+				if err != nil {
+					log.Fatal(err)
+				}
+				if resp.StatusCode != http.StatusOK {
+					log.Fatalf("Invalid status code: %d", resp.StatusCode)
+				}
+				for _, t := range timeline {
+					if e, ok := timelineEntry(t); ok {
+						entries = append(entries, e)
+					}
+				}
+				if opts.Page = github.NextPage(resp); opts.Page == 0 {
+					break
+				}
+			}
+		} else {
+			opts := &github.ListOptions{PerPage: min(limit, 100)}
+			for len(entries) < limit {
+				events, resp, err := client.Issues.ListRepositoryEvents(cmd.Context(), cfg.Owner, cfg.Repo, opts)
+				if err != nil {
+					log.Fatal(err)
+				}
+				if resp.StatusCode != http.StatusOK {
+					log.Fatalf("Invalid status code: %d", resp.StatusCode)
+				}
+				for _, e := range events {
+					entries = append(entries, repositoryEventEntry(e))
+				}
+				if opts.Page = github.NextPage(resp); opts.Page == 0 {
+					break
+				}
+			}
+			entries = entries[:min(len(entries), limit)]
+		}
+
+		// print result in chronological order
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].at.Before(entries[j].at) })
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, e := range entries {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", formatTime(e.at), e.actor, e.action)
+		}
+		w.Flush()
+	},
+}
+
+// timelineEntry describes a timeline item in words. Items without a
+// meaningful description are skipped.
+func timelineEntry(t *github.Timeline) (historyEntry, bool) {
+	e := historyEntry{at: t.GetCreatedAt(), actor: t.GetActor().GetLogin()}
+	switch t.GetEvent() {
+	case "commented":
+		e.actor = t.GetUser().GetLogin()
+		e.action = "commented: " + truncate(firstLine(t.GetBody()), 60)
+	case "cross-referenced":
+		source := t.GetSource().GetIssue()
+		kind := "issue"
+		if source.IsPullRequest() {
+			kind = "pull request"
+		}
+		e.action = fmt.Sprintf("mentioned this in %s %s#%d", kind, source.RepositoryFullName(), source.GetNumber())
+	case "committed":
+		e.at, e.actor = t.GetAuthor().GetDate(), t.GetAuthor().GetName()
+		e.action = fmt.Sprintf("committed %.7s %s", t.GetSHA(), truncate(firstLine(t.GetMessage()), 60))
+	default:
+		desc, ok := describeEvent(t.GetEvent(), &github.IssueEvent{
+			CommitID:    t.CommitID,
+			Label:       t.Label,
+			Assignee:    t.Assignee,
+			Milestone:   t.Milestone,
+			Rename:      t.Rename,
+			StateReason: t.StateReason,
+			LockReason:  t.LockReason,
+		}, "this")
+		if !ok {
+			return e, false
+		}
+		e.action = desc
+	}
+	return e, true
+}
+
+// repositoryEventEntry describes a repository issue event, naming its issue.
+func repositoryEventEntry(event *github.IssueEvent) historyEntry {
+	ref := fmt.Sprintf("#%d", event.GetIssue().GetNumber())
+	desc, ok := describeEvent(event.GetEvent(), event, ref)
+	if !ok {
+		desc = event.GetEvent() + " " + ref
+	}
+	return historyEntry{event.GetCreatedAt(), event.GetActor().GetLogin(), desc}
+}
+
+// describeEvent describes an issue event in words, referring to the issue as
+// subject, e.g. "added label bug to this".
+func describeEvent(name string, e *github.IssueEvent, subject string) (string, bool) {
+	switch name {
+	case "labeled":
+		return "added label " + e.GetLabel().GetName() + " to " + subject, true
+	case "unlabeled":
+		return "removed label " + e.GetLabel().GetName() + " from " + subject, true
+	case "assigned":
+		return "assigned " + e.GetAssignee().GetLogin() + " to " + subject, true
+	case "unassigned":
+		return "unassigned " + e.GetAssignee().GetLogin() + " from " + subject, true
+	case "milestoned":
+		return "added " + subject + " to milestone " + e.GetMilestone().GetTitle(), true
+	case "demilestoned":
+		return "removed " + subject + " from milestone " + e.GetMilestone().GetTitle(), true
+	case "renamed":
+		return fmt.Sprintf("renamed %s from %q to %q", subject, e.GetRename().GetFrom(), e.GetRename().GetTo()), true
+	case "closed":
+		if reason := e.GetStateReason(); reason != "" {
+			return "closed " + subject + " as " + strings.ReplaceAll(reason, "_", " "), true
+		}
+		return "closed " + subject, true
+	case "reopened":
+		return "reopened " + subject, true
+	case "referenced":
+		return fmt.Sprintf("referenced %s in commit %.7s", subject, e.GetCommitID()), true
+	case "locked":
+		if reason := e.GetLockReason(); reason != "" {
+			return "locked " + subject + " as " + reason, true
+		}
+		return "locked " + subject, true
+	case "unlocked":
+		return "unlocked " + subject, true
+	case "pinned", "unpinned", "transferred", "marked_as_duplicate", "unmarked_as_duplicate":
+		return strings.ReplaceAll(name, "_", " ") + " " + subject, true
+	default:
+		return "", false
+	}
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return strings.TrimSpace(line)
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+
+	// set optional flags
+	historyCmd.Flags().Int("number", 0, "issue number")
+	historyCmd.Flags().Int("limit", 50, "maximum number of repository events without --number")
+}
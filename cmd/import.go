@@ -0,0 +1,347 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"cli-github-issues/internal/importer"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// contentInterval is the default time between requests that create content,
+// following GitHub's guidance for staying within secondary rate limits.
+const contentInterval = time.Second
+
+var importCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Create issues from a CSV or JSON Lines file",
+	Long: `Create an issue for every record of a CSV or JSON Lines file, such as a
+spreadsheet or an export of another tracker.
+
+A mapping file (YAML or JSON) names the fields holding the source ID, title,
+body, labels, assignees, milestone and state of the records:
+
+  id: Issue key
+  title: Summary
+  body: Description
+  labels: [Labels, Priority]
+  assignees: Assignee
+  milestone: Fix Version
+  state: Status
+  closed_states: [Done, Resolved]
+  users: {jdoe: johndoe}
+  extra_fields: [Reporter, Created]
+
+Missing labels and milestones are created first. Issues are created one at a
+time, --interval apart, to stay within GitHub's secondary rate limits. Each
+created issue is recorded in a ledger by source ID, so running the import
+again skips the records it already created. With --dry-run the requests are
+printed instead and the ledger is left as it is.`,
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get import params from cli
+		mappingPath := flagMustExist(cmd.Flags().GetString("mapping"))
+		format := flagMustExist(cmd.Flags().GetString("format"))
+		ledgerPath := flagMustExist(cmd.Flags().GetString("ledger"))
+		interval := flagMustExist(cmd.Flags().GetDuration("interval"))
+		labelColor := flagMustExist(cmd.Flags().GetString("label-color"))
+		if format == "" {
+			format = importer.FormatOf(args[0])
+		}
+		if !slices.Contains(importer.Formats, format) {
+			log.Fatalf("Cannot tell the format of %s, set --format to one of %v", args[0], importer.Formats)
+		}
+		if ledgerPath == "" {
+			ledgerPath = args[0] + ".ledger.json"
+		}
+
+		// map every record before creating anything
+		mapping, err := importer.LoadMapping(mappingPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		issues := mustReadImport(args[0], format, mapping)
+
+		ledger, err := importer.OpenLedger(ledgerPath, repoRef{cfg.Owner, cfg.Repo}.String())
+		if err != nil {
+			log.Fatal(err)
+		}
+		var pending []*importer.Issue
+		for _, issue := range issues {
+			if _, ok := ledger.Number(issue.SourceID); !ok {
+				pending = append(pending, issue)
+			}
+		}
+		skipped := len(issues) - len(pending)
+		if len(pending) == 0 {
+			fmt.Printf("Nothing to import, all %d records were imported before\n", skipped)
+			return
+		}
+
+		ctx := cmd.Context()
+		gate := &rateGate{}
+		pace := &throttle{interval: interval}
+		if dryRun {
+			pace.interval = 0
+		}
+
+		// create missing labels and milestones
+		var (
+			labels []*github.Label
+			titles []string
+			seen   = map[string]bool{}
+		)
+		for _, issue := range pending {
+			for _, name := range issue.Labels {
+				if key := strings.ToLower(name); !seen[key] {
+					seen[key] = true
+					labels = append(labels, &github.Label{Name: github.String(name), Color: github.String(labelColor)})
+				}
+			}
+			if issue.Milestone != "" {
+				titles = append(titles, issue.Milestone)
+			}
+		}
+		mustEnsureLabels(ctx, gate, pace, repoRef{cfg.Owner, cfg.Repo}, labels)
+		milestones := mustEnsureMilestones(ctx, gate, pace, titles)
+
+		// create issues in input order
+		failed := 0
+		for _, issue := range pending {
+			number, err := importIssue(ctx, gate, pace, issue, milestones[strings.ToLower(issue.Milestone)])
+			if number != 0 {
+				if err := ledger.Add(issue.SourceID, number); err != nil {
+					log.Fatal(err)
+				}
+			}
+			switch {
+			case err != nil:
+				failed++
+				fmt.Fprintf(os.Stderr, "%s: %s\n", issue.SourceID, err)
+			case dryRun:
+				fmt.Printf("%s: would be created\n", issue.SourceID)
+			default:
+				fmt.Printf("%s: created #%d\n", issue.SourceID, number)
+			}
+		}
+
+		created := "created"
+		if dryRun {
+			created = "would be created"
+		}
+		fmt.Printf("\n%d %s, %d imported before, %d failed\n", len(pending)-failed, created, skipped, failed)
+		if failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+// mustReadImport reads and maps the records of the file at path, exiting on
+// the first invalid or duplicate record.
+func mustReadImport(path string, format string, mapping *importer.Mapping) []*importer.Issue {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	records, err := importer.ReadRecords(f, format)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	issues := make([]*importer.Issue, 0, len(records))
+	seen := map[string]bool{}
+	for i, record := range records {
+		issue, err := mapping.Issue(record)
+		if err != nil {
+			log.Fatalf("record %d: %s", i+1, err)
+		}
+		if seen[issue.SourceID] {
+			log.Fatalf("record %d: duplicate source ID %s", i+1, issue.SourceID)
+		}
+		seen[issue.SourceID] = true
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// importIssue creates issue, closing it if it is closed in the source, and
+// returns its number. The number is returned even when closing fails. A dry
+// run creates no issue, so there is none to close and the number is 0.
+func importIssue(ctx context.Context, gate *rateGate, pace *throttle, issue *importer.Issue, milestone int) (int, error) {
+	var created *github.Issue
+	err := gate.do(ctx, func() (*http.Response, error) {
+		if err := pace.wait(ctx); err != nil {
+			return nil, err
+		}
+		var (
+			resp *http.Response
+			err  error
+		)
+		created, resp, err = client.Issues.Create(cfg.Owner, cfg.Repo, issue.Request(milestone))
+		return resp, err
+	})
+	if err != nil {
+		return 0, err
+	}
+	number := created.GetNumber()
+	if !issue.Closed || number == 0 {
+		return number, nil
+	}
+
+	err = gate.do(ctx, func() (*http.Response, error) {
+		if err := pace.wait(ctx); err != nil {
+			return nil, err
+		}
+		_, resp, err := client.Issues.Update(cfg.Owner, cfg.Repo, number, &github.IssueRequest{
+			State:       github.String("closed"),
+			StateReason: github.String("completed"),
+		})
+		return resp, err
+	})
+	if err != nil {
+		return number, fmt.Errorf("created #%d but could not close it: %w", number, err)
+	}
+	return number, nil
+}
+
+// mustEnsureLabels creates those of labels, with their color and
+// description, that repo does not have yet, comparing names
+// case-insensitively as GitHub does.
+func mustEnsureLabels(ctx context.Context, gate *rateGate, pace *throttle, repo repoRef, labels []*github.Label) {
+	existing := map[string]bool{}
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := client.Issues.ListLabels(ctx, repo.owner, repo.name, opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+		for _, label := range page {
+			existing[strings.ToLower(label.GetName())] = true
+		}
+
+		if opts.Page = github.NextPage(resp); opts.Page == 0 {
+			break
+		}
+	}
+
+	for _, label := range labels {
+		name := label.GetName()
+		if existing[strings.ToLower(name)] {
+			continue
+		}
+		existing[strings.ToLower(name)] = true
+		err := gate.do(ctx, func() (*http.Response, error) {
+			if err := pace.wait(ctx); err != nil {
+				return nil, err
+			}
+			_, resp, err := client.Issues.CreateLabel(ctx, repo.owner, repo.name, &github.Label{
+				Name:        label.Name,
+				Color:       label.Color,
+				Description: label.Description,
+			})
+			return resp, err
+		})
+		if err != nil {
+			log.Fatalf("label %s: %s", name, err)
+		}
+		fmt.Printf("Created label %s in %s\n", name, repo)
+	}
+}
+
+// mustEnsureMilestones creates the milestones out of titles that the
+// repository does not have yet, and returns the number of every milestone
+// by lowercase title. Milestones created by a dry run have number 0.
+func mustEnsureMilestones(ctx context.Context, gate *rateGate, pace *throttle, titles []string) map[string]int {
+	numbers := map[string]int{}
+	if len(titles) == 0 {
+		return numbers
+	}
+	opts := &github.MilestoneListOptions{State: "all", ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		milestones, resp, err := client.Issues.ListMilestones(ctx, cfg.Owner, cfg.Repo, opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+		for _, m := range milestones {
+			numbers[strings.ToLower(m.GetTitle())] = m.GetNumber()
+		}
+
+		if opts.Page = github.NextPage(resp); opts.Page == 0 {
+			break
+		}
+	}
+
+	for _, title := range titles {
+		key := strings.ToLower(title)
+		if _, ok := numbers[key]; ok {
+			continue
+		}
+		var created *github.Milestone
+		err := gate.do(ctx, func() (*http.Response, error) {
+			if err := pace.wait(ctx); err != nil {
+				return nil, err
+			}
+			var (
+				resp *http.Response
+				err  error
+			)
+			created, resp, err = client.Issues.CreateMilestone(ctx, cfg.Owner, cfg.Repo, &github.Milestone{Title: github.String(title)})
+			return resp, err
+		})
+		if err != nil {
+			log.Fatalf("milestone %s: %s", title, err)
+		}
+		numbers[key] = created.GetNumber()
+		fmt.Printf("Created milestone %s\n", title)
+	}
+	return numbers
+}
+
+// throttle spaces out requests that create content, which GitHub's secondary
+// rate limits restrict to far fewer than the hourly limit allows.
+type throttle struct {
+	interval time.Duration
+	last     time.Time
+}
+
+// wait blocks until interval has passed since the previous call.
+func (t *throttle) wait(ctx context.Context) error {
+	if d := time.Until(t.last.Add(t.interval)); d > 0 {
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	t.last = time.Now()
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+
+	// set required flag
+	importCmd.Flags().String("mapping", "", "file mapping input fields to issue fields")
+	importCmd.MarkFlagRequired("mapping")
+
+	// set optional flags
+	importCmd.Flags().String("format", "", "input format: csv or jsonl; by default from the file extension")
+	importCmd.Flags().String("ledger", "", "file recording the created issues by source ID (default <file>.ledger.json)")
+	importCmd.Flags().Duration("interval", contentInterval, "time between requests that create content")
+	importCmd.Flags().String("label-color", "ededed", "color of created labels")
+}
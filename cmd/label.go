@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/mirror"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var labelCmd = &cobra.Command{
+	Use:   "label",
+	Short: "Add or remove labels of an issue",
+}
+
+var labelAddCmd = &cobra.Command{
+	Use:         "add <label>...",
+	Short:       "Add labels to an issue",
+	Args:        cobra.MinimumNArgs(1),
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get label params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		if flagMustExist(cmd.Flags().GetBool("offline")) {
+			mustEnqueue(&mirror.Change{Kind: mirror.ChangeAddLabels, Number: number, Labels: args})
+			return
+		}
+
+		// add labels
+		labels, resp, err := client.Issues.AddLabelsToIssue(cmd.Context(), cfg.Owner, cfg.Repo, number, args)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// check and print result
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+		names := make([]string, 0, len(labels))
+		for _, label := range labels {
+			names = append(names, label.GetName())
+		}
+		fmt.Printf("#%d labels: %s\n", number, strings.Join(names, ", "))
+	},
+}
+
+var labelRemoveCmd = &cobra.Command{
+	Use:         "remove <label>...",
+	Short:       "Remove labels from an issue",
+	Args:        cobra.MinimumNArgs(1),
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get label params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		if flagMustExist(cmd.Flags().GetBool("offline")) {
+			mustEnqueue(&mirror.Change{Kind: mirror.ChangeRemoveLabels, Number: number, Labels: args})
+			return
+		}
+
+		// remove labels one by one
+		for _, label := range args {
+			resp, err := client.Issues.RemoveLabelForIssue(cmd.Context(), cfg.Owner, cfg.Repo, number, label)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				log.Fatalf("Invalid status code: %d", resp.StatusCode)
+			}
+			fmt.Printf("Removed %s from #%d\n", label, number)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(labelCmd)
+	labelCmd.AddCommand(labelAddCmd)
+	labelCmd.AddCommand(labelRemoveCmd)
+
+	// set required flag
+	for _, c := range []*cobra.Command{labelAddCmd, labelRemoveCmd} {
+		c.Flags().Int("number", 0, "issue number")
+		c.MarkFlagRequired("number")
+		c.Flags().Bool("offline", false, "queue the change to be sent by sync push")
+	}
+}
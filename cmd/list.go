@@ -0,0 +1,256 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List issues of the specified repositories",
+	Long: `List issues of the specified repositories.
+
+Issues of several repositories, given with repeated --repo flags, the
+github.repos config list or --org, are fetched concurrently and merged into a
+single table with a repository column.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// get list params from cli
+		limit := mustLimit(cmd)
+		opts := &github.IssueListByRepoOptions{
+			State:     flagMustExist(cmd.Flags().GetString("state")),
+			Labels:    flagMustExist(cmd.Flags().GetStringSlice("label")),
+			Assignee:  flagMustExist(cmd.Flags().GetString("assignee")),
+			Creator:   flagMustExist(cmd.Flags().GetString("author")),
+			Milestone: flagMustExist(cmd.Flags().GetString("milestone")),
+			Sort:      flagMustExist(cmd.Flags().GetString("sort")),
+			Direction: flagMustExist(cmd.Flags().GetString("direction")),
+		}
+
+		// list mirrored issues
+		if flagMustExist(cmd.Flags().GetBool("offline")) {
+			m := mustOpenMirror()
+			defer m.Close()
+			repos := offlineRepos(m, flagMustExist(cmd.Flags().GetString("org")))
+			issues := mustOfflineIssues(m, repos, listSearchQuery(nil, opts))
+			sortIssues(issues, opts.Sort, opts.Direction)
+			printIssueTable(issues[:min(len(issues), limit)], tableOptions{repo: len(repos) > 1, reactions: isReactionSort(opts.Sort)})
+			return
+		}
+
+		repos := mustTargetRepos(cmd)
+		table := tableOptions{repo: len(repos) > 1}
+
+		// the list endpoint cannot sort by reactions, the Search API can
+		if isReactionSort(opts.Sort) {
+			// the milestone qualifier matches titles, not numbers
+			searchOpts := *opts
+			if number, err := strconv.Atoi(opts.Milestone); err == nil {
+				searchOpts.Milestone = mustMilestoneTitle(cmd.Context(), repos, number)
+			}
+			issues, _ := mustSearchIssues(cmd.Context(), listSearchQuery(repos, &searchOpts), &github.SearchOptions{
+				Sort:        opts.Sort,
+				Order:       opts.Direction,
+				ListOptions: github.ListOptions{PerPage: min(limit, 100)},
+			}, limit)
+			table.reactions = true
+			printIssueTable(issues, table)
+			return
+		}
+
+		// list issues of every repository concurrently
+		results := make([][]*github.Issue, len(repos))
+		errs := forEachRepo(cmd.Context(), repos, func(ctx context.Context, i int, repo repoRef) error {
+			var err error
+			results[i], err = listRepoIssues(ctx, repo, *opts, limit)
+			return err
+		})
+		for i, err := range errs {
+			if err != nil {
+				log.Fatalf("%s: %s", repos[i], err)
+			}
+		}
+
+		// merge results
+		var issues []*github.Issue
+		for _, result := range results {
+			issues = append(issues, result...)
+		}
+		if len(repos) > 1 {
+			sortIssues(issues, opts.Sort, opts.Direction)
+			issues = issues[:min(len(issues), limit)]
+		}
+
+		// print result
+		printIssueTable(issues, table)
+	},
+}
+
+// listRepoIssues returns up to limit issues of repo, skipping pull requests.
+func listRepoIssues(ctx context.Context, repo repoRef, opts github.IssueListByRepoOptions, limit int) ([]*github.Issue, error) {
+	var issues []*github.Issue
+	opts.PerPage = min(limit, 100)
+	for len(issues) < limit {
+		page, resp, err := client.Issues.ListByRepo(ctx, repo.owner, repo.name, &opts)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("invalid status code: %d", resp.StatusCode)
+		}
+		for _, issue := range page {
+			if !issue.IsPullRequest() && len(issues) < limit {
+				issues = append(issues, issue)
+			}
+		}
+		if opts.Page = github.NextPage(resp); opts.Page == 0 {
+			break
+		}
+	}
+	return issues, nil
+}
+
+// sortIssues sorts issues merged from several repositories or read from the
+// mirror the way the API sorts them: by created, updated, comments or
+// reactions, descending by default.
+func sortIssues(issues []*github.Issue, sort string, direction string) {
+	key := func(issue *github.Issue) int64 {
+		switch {
+		case sort == "updated":
+			return issue.GetUpdatedAt().UnixNano()
+		case sort == "comments":
+			return int64(issue.GetComments())
+		case isReactionSort(sort):
+			return int64(reactionCount(issue, sort))
+		default:
+			return issue.GetCreatedAt().UnixNano()
+		}
+	}
+	slices.SortStableFunc(issues, func(a, b *github.Issue) int {
+		if direction == "asc" {
+			return cmp.Compare(key(a), key(b))
+		}
+		return cmp.Compare(key(b), key(a))
+	})
+}
+
+// mustMilestoneTitle returns the title of milestone number in repos, exiting
+// if it differs between them as a single search qualifier cannot match both.
+func mustMilestoneTitle(ctx context.Context, repos []repoRef, number int) string {
+	titles := map[string]bool{}
+	for _, repo := range repos {
+		opts := &github.MilestoneListOptions{State: "all", ListOptions: github.ListOptions{PerPage: 100}}
+		for {
+			milestones, resp, err := client.Issues.ListMilestones(ctx, repo.owner, repo.name, opts)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				log.Fatalf("Invalid status code: %d", resp.StatusCode)
+			}
+			for _, m := range milestones {
+				if m.GetNumber() == number {
+					titles[m.GetTitle()] = true
+				}
+			}
+
+			if opts.Page = github.NextPage(resp); opts.Page == 0 {
+				break
+			}
+		}
+	}
+
+	if len(titles) > 1 {
+		log.Fatalf("milestone %d has different titles across repositories, list them one at a time", number)
+	}
+	for title := range titles {
+		return title
+	}
+	log.Fatalf("milestone %d not found", number)
+	return ""
+}
+
+// isReactionSort reports whether sort is "reactions" or one of the sorts by
+// a single reaction, such as "reactions-+1".
+func isReactionSort(sort string) bool {
+	return strings.HasPrefix(sort, "reactions")
+}
+
+// reactionCount returns the number of reactions of issue that the reaction
+// sort counts, using the names the Search API sorts by.
+func reactionCount(issue *github.Issue, sort string) int {
+	r := issue.GetReactions()
+	switch strings.TrimPrefix(sort, "reactions-") {
+	case "+1":
+		return r.GetPlusOne()
+	case "-1":
+		return r.GetMinusOne()
+	case "smile":
+		return r.GetLaugh()
+	case "thinking_face":
+		return r.GetConfused()
+	case "heart":
+		return r.GetHeart()
+	case "tada":
+		return r.GetHooray()
+	default:
+		return r.GetTotalCount()
+	}
+}
+
+// listSearchQuery translates list filters into an equivalent search query.
+func listSearchQuery(repos []repoRef, opts *github.IssueListByRepoOptions) string {
+	terms := append(repoQualifiers(repos), "is:issue")
+	if opts.State != "" && opts.State != "all" {
+		terms = append(terms, github.Qualifier("state", opts.State))
+	}
+	for _, label := range opts.Labels {
+		terms = append(terms, github.Qualifier("label", label))
+	}
+	switch opts.Assignee {
+	case "":
+	case "none":
+		terms = append(terms, "no:assignee")
+	case "*":
+		terms = append(terms, "-no:assignee")
+	default:
+		terms = append(terms, github.Qualifier("assignee", opts.Assignee))
+	}
+	if opts.Creator != "" {
+		terms = append(terms, github.Qualifier("author", opts.Creator))
+	}
+	switch opts.Milestone {
+	case "":
+	case "none":
+		terms = append(terms, "no:milestone")
+	case "*":
+		terms = append(terms, "-no:milestone")
+	default:
+		terms = append(terms, github.Qualifier("milestone", opts.Milestone))
+	}
+	return strings.Join(terms, " ")
+}
+
+func init() {
+	rootCmd.AddCommand(listCmd)
+
+	// set optional flags
+	listCmd.Flags().String("state", "open", "filter by state: open, closed or all")
+	listCmd.Flags().StringSlice("label", nil, "filter by label names")
+	listCmd.Flags().String("assignee", "", "filter by assignee, \"none\" or \"*\"")
+	listCmd.Flags().String("author", "", "filter by author")
+	listCmd.Flags().String("milestone", "", "filter by milestone number, \"none\" or \"*\"")
+	listCmd.Flags().String("sort", "", "sort by created, updated, comments, reactions or one reaction such as reactions-+1")
+	listCmd.Flags().String("direction", "", "sort direction: asc or desc")
+	listCmd.Flags().Int("limit", 30, "maximum number of issues to list")
+	listCmd.Flags().String("org", "", "list the issues of every repository of an organization")
+	listCmd.Flags().Bool("offline", false, "list issues from the local mirror updated by sync")
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+
+	"github.com/spf13/cobra"
+)
+
+var lockCmd = &cobra.Command{
+	Use:         "lock",
+	Short:       "Lock the conversation of an issue on the specified repository",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get lock params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		reason := flagMustExist(cmd.Flags().GetString("reason"))
+		if reason != "" && !slices.Contains(github.LockReasons, reason) {
+			log.Fatalf("Invalid reason %q, expected one of %q", reason, github.LockReasons)
+		}
+
+		// lock issue
+		resp, err := client.Issues.Lock(cmd.Context(), cfg.Owner, cfg.Repo, number, reason)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// check and print result
+		if resp.StatusCode == http.StatusNoContent {
+			printLockState(mustGetIssue(number))
+		} else {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+	},
+}
+
+// printLockState prints whether the issue is locked and why.
+func printLockState(issue *github.Issue) {
+	state := "unlocked"
+	if issue.GetLocked() {
+		state = "locked"
+		if reason := issue.GetActiveLockReason(); reason != "" {
+			state += " as " + reason
+		}
+	}
+	fmt.Printf("#%-5d %s\n", issue.GetNumber(), state)
+}
+
+func init() {
+	rootCmd.AddCommand(lockCmd)
+
+	// set required flag
+	lockCmd.Flags().Int("number", 0, "issue number")
+	lockCmd.MarkFlagRequired("number")
+
+	// set optional flags
+	lockCmd.Flags().String("reason", "", `reason for locking: "off-topic", "too heated", "resolved" or "spam"`)
+}
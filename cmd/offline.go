@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"cli-github-issues/internal/mirror"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// mirrorStaleAfter is the age after which reading a mirror warns that it is stale.
+const mirrorStaleAfter = 24 * time.Hour
+
+// mustOfflineIssues returns the mirrored issues of repos matching query,
+// reporting how old the mirror of each repository is.
+func mustOfflineIssues(m *mirror.Mirror, repos []string, query string) []*github.Issue {
+	q, err := mirror.ParseQuery(query)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var issues []*github.Issue
+	for _, repo := range repos {
+		if !q.InScope(repo) {
+			continue
+		}
+		mustReportStaleness(m, repo)
+		all, err := m.Issues(repo)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, issue := range all {
+			if q.Match(issue) {
+				issues = append(issues, issue)
+			}
+		}
+	}
+	return issues
+}
+
+// mustOfflineIssue returns a mirrored issue of the configured repository and,
+// if withComments is set, its comments.
+func mustOfflineIssue(m *mirror.Mirror, number int, withComments bool) (*github.Issue, []*github.IssueComment) {
+	repo := repoRef{cfg.Owner, cfg.Repo}.String()
+	mustReportStaleness(m, repo)
+
+	issue, err := m.Issue(repo, number)
+	if errors.Is(err, mirror.ErrNotFound) {
+		log.Fatalf("Issue #%d of %s is not mirrored, run sync to update the mirror", number, repo)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	var comments []*github.IssueComment
+	if withComments {
+		if comments, err = m.Comments(repo, number); err != nil {
+			log.Fatal(err)
+		}
+	}
+	return issue, comments
+}
+
+// offlineRepos returns the mirrored repositories owned by org, or the
+// configured repositories without org.
+func offlineRepos(m *mirror.Mirror, org string) []string {
+	if org == "" {
+		var repos []string
+		for _, repo := range configuredRepos() {
+			repos = append(repos, repo.String())
+		}
+		return repos
+	}
+
+	all, err := m.Repos()
+	if err != nil {
+		log.Fatal(err)
+	}
+	var repos []string
+	for _, repo := range all {
+		if owner, _, _ := strings.Cut(repo, "/"); strings.EqualFold(owner, org) {
+			repos = append(repos, repo)
+		}
+	}
+	return repos
+}
+
+// mustReportStaleness prints when repo was last synced, failing if it never was.
+func mustReportStaleness(m *mirror.Mirror, repo string) {
+	state, err := m.SyncState(repo)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if state.LastSync.IsZero() {
+		log.Fatalf("%s is not mirrored, run sync first", repo)
+	}
+
+	age := time.Since(state.LastSync)
+	fmt.Fprintf(os.Stderr, "Offline: %s synced %s ago\n", repo, age.Round(time.Minute))
+	if age > mirrorStaleAfter {
+		fmt.Fprintf(os.Stderr, "warning: the mirror of %s is stale, run sync to update it\n", repo)
+	}
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+)
+
+var pinCmd = &cobra.Command{
+	Use:         "pin",
+	Short:       "Pin an issue to the issue list of the specified repository",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get issue number from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+
+		// pin issue by its node id
+		issue := mustGetIssue(number)
+		if _, err := client.Issues.Pin(cmd.Context(), issue.GetNodeID()); err != nil {
+			log.Fatal(err)
+		}
+
+		// print result
+		fmt.Printf("#%-5d pinned\n", issue.GetNumber())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(pinCmd)
+
+	// set required flag
+	pinCmd.Flags().Int("number", 0, "issue number")
+	pinCmd.MarkFlagRequired("number")
+}
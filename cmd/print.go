@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// printIssue prints a short one-line summary of the issue.
+func printIssue(issue *github.Issue) {
+	fmt.Printf("#%-5d %9.9s %.55s %q\n", issue.GetNumber(), issue.GetUser().GetLogin(), issue.GetTitle(), issue.GetBody())
+}
+
+// tableOptions selects the optional columns of printIssueTable.
+type tableOptions struct {
+	// repo adds a leading repository column for results spanning several repositories.
+	repo bool
+
+	// reactions adds a column with the total number of reactions.
+	reactions bool
+}
+
+// printIssueTable prints one aligned row per issue.
+func printIssueTable(issues []*github.Issue, opts tableOptions) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, issue := range issues {
+		if opts.repo {
+			fmt.Fprintf(w, "%s\t", issue.RepositoryFullName())
+		}
+		if opts.reactions {
+			fmt.Fprintf(w, "%d\t", issue.GetReactions().GetTotalCount())
+		}
+		fmt.Fprintf(w, "#%d\t%s\t%.9s\t%s\t%s\t%s\n",
+			issue.GetNumber(),
+			issue.GetState(),
+			issue.GetUser().GetLogin(),
+			truncate(issue.GetTitle(), 55),
+			strings.Join(issue.LabelNames(), ", "),
+			formatTime(issue.GetUpdatedAt()),
+		)
+	}
+	w.Flush()
+}
+
+// truncate shortens s to at most n runes, marking the cut with an ellipsis.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
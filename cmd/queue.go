@@ -0,0 +1,342 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/ansi"
+	"cli-github-issues/internal/github"
+	"cli-github-issues/internal/mirror"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var syncPushCmd = &cobra.Command{
+	Use:   "push",
+	Short: "Send the changes queued with --offline to GitHub",
+	Long: `Send the changes queued with --offline to GitHub, in the order they were made.
+
+Before an update is sent, the issue is compared with the copy the change was
+based on. Title and body edits made on GitHub in the meantime are merged with
+the queued edit where they touch different lines; otherwise the change is
+reported as a conflict with a three-way view and stays queued. Drop it with
+"sync drop" to keep the version on GitHub, or push it with --force to
+overwrite that version.`,
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get push params from cli
+		force := flagMustExist(cmd.Flags().GetBool("force"))
+
+		m := mustOpenMirror()
+		defer m.Close()
+		changes, err := m.Queue()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(changes) == 0 {
+			fmt.Println("Nothing to push")
+			return
+		}
+
+		// replay changes, holding back later changes of an issue whose
+		// earlier change was not pushed
+		failed := 0
+		blocked := map[string]bool{}
+		for _, c := range changes {
+			target := changeTarget(c)
+			if blocked[target] {
+				failed++
+				fmt.Fprintf(os.Stderr, "change %d: skipped, an earlier change of %s was not pushed\n", c.ID, target)
+				continue
+			}
+
+			issue, err := replayChange(cmd.Context(), c, force)
+			var conflict *changeConflict
+			switch {
+			case errors.As(err, &conflict):
+				printConflict(conflict)
+			case err != nil:
+				fmt.Fprintf(os.Stderr, "change %d: %s %s: %s\n", c.ID, c.Kind, target, err)
+			}
+			if err != nil {
+				failed++
+				if c.Number != 0 {
+					blocked[target] = true
+				}
+				continue
+			}
+
+			// keep the mirror up to date with the result
+			if err := m.Dequeue(c.ID); err != nil {
+				log.Fatal(err)
+			}
+			if issue != nil {
+				if err := m.PutIssues(c.Repo, []*github.Issue{issue}); err != nil {
+					log.Fatal(err)
+				}
+				target = fmt.Sprintf("%s#%d", c.Repo, issue.GetNumber())
+			}
+			fmt.Printf("Pushed change %d: %s %s\n", c.ID, c.Kind, target)
+		}
+
+		fmt.Printf("\n%d pushed, %d left in the queue\n", len(changes)-failed, failed)
+		if failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+var syncQueueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "List the changes queued with --offline",
+	Run: func(cmd *cobra.Command, args []string) {
+		m := mustOpenMirror()
+		defer m.Close()
+		changes, err := m.Queue()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// print result
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, c := range changes {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", c.ID, c.Kind, changeTarget(c), formatTime(c.QueuedAt), describeChange(c))
+		}
+		w.Flush()
+	},
+}
+
+var syncDropCmd = &cobra.Command{
+	Use:   "drop <id>...",
+	Short: "Remove queued changes without sending them",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		m := mustOpenMirror()
+		defer m.Close()
+
+		for _, arg := range args {
+			id, err := strconv.ParseUint(arg, 10, 64)
+			if err != nil {
+				log.Fatalf("Invalid change id %q", arg)
+			}
+			if err := m.Dequeue(id); err != nil {
+				log.Fatalf("change %d: %s", id, err)
+			}
+			fmt.Printf("Dropped change %d\n", id)
+		}
+	},
+}
+
+// changeConflict is an update whose title or body was also changed on GitHub.
+type changeConflict struct {
+	change *mirror.Change
+	remote *github.Issue
+
+	// title holds the base, remote and queued title when they conflict.
+	title []string
+
+	// body is the merged body with conflict markers, or "" without conflict.
+	body string
+}
+
+func (c *changeConflict) Error() string {
+	return "changed on GitHub since the change was queued"
+}
+
+// mustEnqueue queues an offline change of an issue of the configured
+// repository, snapshotting the mirrored issue it is based on as it stands
+// after the changes already queued for it.
+func mustEnqueue(c *mirror.Change) {
+	m := mustOpenMirror()
+	defer m.Close()
+
+	c.Repo = repoRef{cfg.Owner, cfg.Repo}.String()
+	c.QueuedAt = time.Now()
+	if c.Number != 0 {
+		base, err := m.Issue(c.Repo, c.Number)
+		if errors.Is(err, mirror.ErrNotFound) {
+			log.Fatalf("Issue #%d of %s is not mirrored, run sync before editing it offline", c.Number, c.Repo)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		queue, err := m.Queue()
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, q := range queue {
+			if q.Repo == c.Repo && q.Number == c.Number {
+				base = q.Apply(base)
+			}
+		}
+		c.Base = base
+	}
+	if err := m.Enqueue(c); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Queued change %d: %s %s, run sync push to send it\n", c.ID, c.Kind, changeTarget(c))
+}
+
+// replayChange sends c to GitHub and returns the resulting issue, if the
+// endpoint returns one.
+func replayChange(ctx context.Context, c *mirror.Change, force bool) (*github.Issue, error) {
+	owner, repo, err := parseRepo(c.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		issue *github.Issue
+		resp  *http.Response
+		want  = http.StatusOK
+	)
+	switch c.Kind {
+	case mirror.ChangeCreate:
+		issue, resp, err = client.Issues.Create(owner, repo, c.Request)
+		want = http.StatusCreated
+	case mirror.ChangeUpdate, mirror.ChangeClose:
+		req := c.Request
+		if c.Kind == mirror.ChangeUpdate {
+			// fields the change left as they were keep their value on GitHub,
+			// even with force
+			req = c.Edits()
+			if !force {
+				if req, err = mergeRemoteEdits(c, req); err != nil {
+					return nil, err
+				}
+			}
+		}
+		issue, resp, err = client.Issues.Update(owner, repo, c.Number, req)
+	case mirror.ChangeComment:
+		_, resp, err = client.Issues.CreateComment(owner, repo, c.Number, &github.IssueComment{Body: github.String(c.Comment)})
+		want = http.StatusCreated
+	case mirror.ChangeAddLabels:
+		_, resp, err = client.Issues.AddLabelsToIssue(ctx, owner, repo, c.Number, c.Labels)
+	case mirror.ChangeRemoveLabels:
+		for _, label := range c.Labels {
+			resp, err = client.Issues.RemoveLabelForIssue(ctx, owner, repo, c.Number, label)
+			// a label removed on GitHub in the meantime is fine
+			if err != nil || (resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound) {
+				break
+			}
+			resp.StatusCode = http.StatusOK
+		}
+	default:
+		return nil, fmt.Errorf("unknown change kind %q", c.Kind)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != want {
+		return nil, fmt.Errorf("invalid status code: %d", resp.StatusCode)
+	}
+	return issue, nil
+}
+
+// mergeRemoteEdits returns req, the edits of the update c, merged with title
+// and body edits made on GitHub since c was queued.
+func mergeRemoteEdits(c *mirror.Change, req *github.IssueRequest) (*github.IssueRequest, error) {
+	owner, repo, err := parseRepo(c.Repo)
+	if err != nil {
+		return nil, err
+	}
+	remote, resp, err := client.Issues.Get(owner, repo, c.Number)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("invalid status code: %d", resp.StatusCode)
+	}
+	if remote.GetUpdatedAt().Equal(c.Base.GetUpdatedAt()) {
+		return req, nil
+	}
+
+	merged := *req
+	conflict := &changeConflict{change: c, remote: remote}
+	if req.Title != nil {
+		base, theirs, ours := c.Base.GetTitle(), remote.GetTitle(), *req.Title
+		if theirs != base && theirs != ours {
+			conflict.title = []string{base, theirs, ours}
+		}
+	}
+	if req.Body != nil && remote.GetBody() != c.Base.GetBody() {
+		body, bad := mirror.Merge3(c.Base.GetBody(), *req.Body, remote.GetBody())
+		if bad {
+			conflict.body = body
+		} else {
+			merged.Body = &body
+		}
+	}
+	if conflict.title != nil || conflict.body != "" {
+		return nil, conflict
+	}
+	return &merged, nil
+}
+
+// printConflict prints a three-way view of a conflicting update.
+func printConflict(c *changeConflict) {
+	painter := newPainter()
+	fmt.Printf("Conflict in change %d: %s %s was changed on GitHub at %s\n",
+		c.change.ID, c.change.Kind, changeTarget(c.change), formatTime(c.remote.GetUpdatedAt()))
+	if c.title != nil {
+		fmt.Println("Title:")
+		fmt.Printf("  base:   %s\n", c.title[0])
+		fmt.Printf("  theirs: %s\n", c.title[1])
+		fmt.Printf("  ours:   %s\n", c.title[2])
+	}
+	if c.body != "" {
+		fmt.Println("Body:")
+		for _, line := range strings.Split(strings.TrimSuffix(c.body, "\n"), "\n") {
+			if strings.HasPrefix(line, "<<<<<<<") || strings.HasPrefix(line, "|||||||") ||
+				strings.HasPrefix(line, "=======") || strings.HasPrefix(line, ">>>>>>>") {
+				line = painter.Paint(line, ansi.Yellow)
+			}
+			fmt.Println("  " + line)
+		}
+	}
+	fmt.Printf("Run \"sync drop %d\" to keep the version on GitHub, or \"sync push --force\" to overwrite it.\n\n", c.change.ID)
+}
+
+// changeTarget names the issue a change applies to.
+func changeTarget(c *mirror.Change) string {
+	if c.Number == 0 {
+		return c.Repo
+	}
+	return fmt.Sprintf("%s#%d", c.Repo, c.Number)
+}
+
+// describeChange summarizes what a change does.
+func describeChange(c *mirror.Change) string {
+	switch c.Kind {
+	case mirror.ChangeCreate, mirror.ChangeUpdate:
+		if c.Request.Title != nil {
+			return truncate(*c.Request.Title, 55)
+		}
+	case mirror.ChangeClose:
+		if c.Request.StateReason != nil {
+			return *c.Request.StateReason
+		}
+	case mirror.ChangeComment:
+		return truncate(firstLine(c.Comment), 55)
+	case mirror.ChangeAddLabels, mirror.ChangeRemoveLabels:
+		return strings.Join(c.Labels, ", ")
+	}
+	return ""
+}
+
+func init() {
+	syncCmd.AddCommand(syncPushCmd)
+	syncCmd.AddCommand(syncQueueCmd)
+	syncCmd.AddCommand(syncDropCmd)
+
+	// set optional flags
+	syncPushCmd.Flags().Bool("force", false, "overwrite title and body edits made on GitHub")
+}
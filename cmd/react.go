@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// reactionAliases maps the emoji shortcodes used on github.com to reaction contents.
+var reactionAliases = map[string]string{
+	"thumbsup":   "+1",
+	"thumbsdown": "-1",
+	"smile":      "laugh",
+	"tada":       "hooray",
+}
+
+var reactCmd = &cobra.Command{
+	Use:   "react",
+	Short: "Add, remove or show reactions of an issue or comment",
+	Long: `Add, remove or show reactions of an issue or comment.
+
+Content is one of +1, -1, laugh, confused, heart, hooray, rocket or eyes.
+Without --content the reaction counts are shown.`,
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get react params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		commentID := flagMustExist(cmd.Flags().GetInt64("comment"))
+		content := flagMustExist(cmd.Flags().GetString("content"))
+		remove := flagMustExist(cmd.Flags().GetBool("remove"))
+		if alias, ok := reactionAliases[content]; ok {
+			content = alias
+		}
+		if number == 0 && commentID == 0 {
+			log.Fatal("one of --number or --comment is required")
+		}
+
+		// show reaction counts
+		if content == "" {
+			printReactionCounts(mustListReactions(cmd, number, commentID))
+			return
+		}
+		if remove {
+			removeReaction(cmd, number, commentID, content)
+			return
+		}
+
+		// add reaction, which returns the existing one if already present
+		var (
+			reaction *github.Reaction
+			resp     *http.Response
+			err      error
+		)
+		if commentID != 0 {
+			reaction, resp, err = client.Reactions.CreateIssueCommentReaction(cmd.Context(), cfg.Owner, cfg.Repo, commentID, content)
+		} else {
+			reaction, resp, err = client.Reactions.CreateIssueReaction(cmd.Context(), cfg.Owner, cfg.Repo, number, content)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+		fmt.Printf("%s %s\n", reactionEmoji(content), reaction.GetUser().GetLogin())
+	},
+}
+
+// removeReaction deletes the reaction with content that the authenticated
+// user added to the issue or comment.
+func removeReaction(cmd *cobra.Command, number int, commentID int64, content string) {
+	// do get authenticated user request
+	user, resp, err := client.Users.Get(cmd.Context(), "")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("Invalid status code: %d", resp.StatusCode)
+	}
+
+	// find own reaction among those with content
+	var id int64
+	for _, r := range mustListContentReactions(cmd, number, commentID, content) {
+		if r.GetUser().GetID() == user.GetID() {
+			id = r.GetID()
+			break
+		}
+	}
+	if id == 0 {
+		log.Fatalf("no such reaction: %s by %s", content, user.GetLogin())
+	}
+
+	// remove reaction by its id
+	if commentID != 0 {
+		resp, err = client.Reactions.DeleteIssueCommentReaction(cmd.Context(), cfg.Owner, cfg.Repo, commentID, id)
+	} else {
+		resp, err = client.Reactions.DeleteIssueReaction(cmd.Context(), cfg.Owner, cfg.Repo, number, id)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		log.Fatalf("Invalid status code: %d", resp.StatusCode)
+	}
+	fmt.Printf("%s removed\n", reactionEmoji(content))
+}
+
+// mustListReactions returns every reaction of the issue or comment.
+func mustListReactions(cmd *cobra.Command, number int, commentID int64) []*github.Reaction {
+	return mustListContentReactions(cmd, number, commentID, "")
+}
+
+// mustListContentReactions returns the reactions with content of the issue
+// or comment, or all of them if content is empty.
+func mustListContentReactions(cmd *cobra.Command, number int, commentID int64, content string) []*github.Reaction {
+	var all []*github.Reaction
+	opts := &github.ListReactionOptions{Content: content, ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		var (
+			reactions []*github.Reaction
+			resp      *http.Response
+			err       error
+		)
+		if commentID != 0 {
+			reactions, resp, err = client.Reactions.ListIssueCommentReactions(cmd.Context(), cfg.Owner, cfg.Repo, commentID, opts)
+		} else {
+			reactions, resp, err = client.Reactions.ListIssueReactions(cmd.Context(), cfg.Owner, cfg.Repo, number, opts)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+		all = append(all, reactions...)
+
+		if opts.Page = github.NextPage(resp); opts.Page == 0 {
+			return all
+		}
+	}
+}
+
+// printReactionCounts prints one line per reaction content with its users.
+func printReactionCounts(reactions []*github.Reaction) {
+	users := map[string][]string{}
+	for _, r := range reactions {
+		users[r.GetContent()] = append(users[r.GetContent()], r.GetUser().GetLogin())
+	}
+	for _, content := range github.ReactionContents {
+		if len(users[content]) > 0 {
+			fmt.Printf("%s %-3d %s\n", reactionEmoji(content), len(users[content]), strings.Join(users[content], ", "))
+		}
+	}
+}
+
+func reactionEmoji(content string) string {
+	switch content {
+	case "+1":
+		return "👍"
+	case "-1":
+		return "👎"
+	case "laugh":
+		return "😄"
+	case "confused":
+		return "😕"
+	case "heart":
+		return "❤️"
+	case "hooray":
+		return "🎉"
+	case "rocket":
+		return "🚀"
+	case "eyes":
+		return "👀"
+	default:
+		return content
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(reactCmd)
+
+	// set optional flags
+	reactCmd.Flags().Int("number", 0, "issue number")
+	reactCmd.Flags().Int64("comment", 0, "comment id, to react to a comment instead of the issue")
+	reactCmd.Flags().String("content", "", "reaction: +1, -1, laugh, confused, heart, hooray, rocket or eyes")
+	reactCmd.Flags().Bool("remove", false, "remove the reaction instead of adding it")
+	reactCmd.MarkFlagsMutuallyExclusive("number", "comment")
+}
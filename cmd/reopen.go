@@ -2,22 +2,29 @@ package cmd
 
 import (
 	"cli-github-issues/internal/github"
-	"fmt"
 	"github.com/spf13/cobra"
 	"log"
 	"net/http"
 )
 
 var reopenCmd = &cobra.Command{
-	Use:   "reopen",
-	Short: "Reopen an issue on the specified repository.",
+	Use:         "reopen",
+	Short:       "Reopen an issue on the specified repository.",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
 	Run: func(cmd *cobra.Command, args []string) {
-		// get issue number from cli
+		// get reopen params from cli
 		number := flagMustExist(cmd.Flags().GetInt("number"))
+		comment := flagMustExist(cmd.Flags().GetString("comment"))
+
+		// post reopening remark
+		if comment != "" {
+			mustCreateComment(number, comment)
+		}
 
 		// reopen issue
 		editedIssue := &github.IssueRequest{
-			State: github.String("open"),
+			State:       github.String("open"),
+			StateReason: github.String("reopened"),
 		}
 		issue, resp, err := client.Issues.Update(cfg.Owner, cfg.Repo, number, editedIssue)
 		if err != nil {
@@ -26,7 +33,7 @@ var reopenCmd = &cobra.Command{
 
 		// check and print result
 		if resp.StatusCode == http.StatusOK {
-			fmt.Printf("#%-5d %9.9s %.55s %q\n", *issue.Number, *issue.User.Login, *issue.Title, *issue.Body)
+			printIssue(issue)
 		} else {
 			log.Fatalf("Invalid status code: %d", resp.StatusCode)
 		}
@@ -39,4 +46,7 @@ func init() {
 	// set required flag
 	reopenCmd.Flags().Int("number", 0, "issue number")
 	reopenCmd.MarkFlagRequired("number")
+
+	// set optional flags
+	reopenCmd.Flags().String("comment", "", "comment to add when reopening")
 }
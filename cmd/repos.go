@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// repoConcurrency is the number of repositories fetched concurrently.
+const repoConcurrency = 8
+
+// repoRef names a repository.
+type repoRef struct {
+	owner string
+	name  string
+}
+
+func (r repoRef) String() string {
+	return r.owner + "/" + r.name
+}
+
+// parseRepoRef parses "owner/repo", or a bare "repo" of the configured owner.
+func parseRepoRef(s string) (repoRef, error) {
+	if !strings.Contains(s, "/") {
+		if cfg.Owner == "" {
+			return repoRef{}, fmt.Errorf("invalid repository %q, expected owner/repo", s)
+		}
+		return repoRef{cfg.Owner, s}, nil
+	}
+	owner, name, err := parseRepo(s)
+	return repoRef{owner, name}, err
+}
+
+// repoFreeCommands are the commands, with their subcommands, that run
+// without a configured repository.
+var repoFreeCommands = []string{"api", "auth", "cache", "completion", "help", "queue"}
+
+// checkRepo exits before running cmd when it needs a repository and none is
+// configured, e.g. by an old config without github.repo or github.repos.
+func checkRepo(cmd *cobra.Command) {
+	if !cmd.Runnable() || cfg.Owner != "" && cfg.Repo != "" {
+		return
+	}
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		if slices.Contains(repoFreeCommands, c.Name()) {
+			return
+		}
+	}
+	if org, _ := cmd.Flags().GetString("org"); org != "" {
+		return
+	}
+	log.Fatalf("no repository configured: set github.repos, or github.owner and github.repo, in %s, or pass --repo owner/repo", viper.ConfigFileUsed())
+}
+
+// configuredRepos returns the repositories given with --repo or listed under
+// github.repos in config, falling back to the single configured repository.
+func configuredRepos() []repoRef {
+	if len(cfg.Repos) == 0 {
+		return []repoRef{{cfg.Owner, cfg.Repo}}
+	}
+	repos := make([]repoRef, 0, len(cfg.Repos))
+	for _, s := range cfg.Repos {
+		repo, err := parseRepoRef(s)
+		if err != nil {
+			log.Fatal(err)
+		}
+		repos = append(repos, repo)
+	}
+	return repos
+}
+
+// mustTargetRepos returns the repositories of the organization given with
+// --org, or the configured repositories.
+func mustTargetRepos(cmd *cobra.Command) []repoRef {
+	org := flagMustExist(cmd.Flags().GetString("org"))
+	if org == "" {
+		return configuredRepos()
+	}
+
+	// list organization repositories, skipping those without issues
+	var repos []repoRef
+	opts := &github.RepositoryListByOrgOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		page, resp, err := client.Repositories.ListByOrg(cmd.Context(), org, opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+		for _, r := range page {
+			if !r.GetArchived() && r.GetHasIssues() {
+				repos = append(repos, repoRef{org, r.GetName()})
+			}
+		}
+		if opts.Page = github.NextPage(resp); opts.Page == 0 {
+			break
+		}
+	}
+	if len(repos) == 0 {
+		log.Fatalf("Organization %s has no repositories with issues", org)
+	}
+	return repos
+}
+
+// repoQualifiers returns a search qualifier for each repository; GitHub
+// matches any of them.
+func repoQualifiers(repos []repoRef) []string {
+	terms := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		terms = append(terms, github.Qualifier("repo", repo.String()))
+	}
+	return terms
+}
+
+// forEachRepo calls fn with every repository and its index, up to
+// repoConcurrency at a time, and returns the errors in the order of repos.
+func forEachRepo(ctx context.Context, repos []repoRef, fn func(ctx context.Context, i int, repo repoRef) error) []error {
+	var (
+		errs = make([]error, len(repos))
+		sem  = make(chan struct{}, repoConcurrency)
+		wg   sync.WaitGroup
+	)
+	for i, repo := range repos {
+		i, repo := i, repo
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = fn(ctx, i, repo)
+		}()
+	}
+	wg.Wait()
+	return errs
+}
@@ -3,34 +3,92 @@ package cmd
 import (
 	"cli-github-issues/internal/config"
 	"cli-github-issues/internal/github"
+	"context"
+	"fmt"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"log"
+	"log/slog"
 	"net/http"
+	"os"
+	"strings"
+	"time"
 )
 
+// envHTTPDebug is the environment variable that switches on HTTP logging.
+const envHTTPDebug = "HTTP_DEBUG"
+
 var (
 	rootCmd = &cobra.Command{
 		Use:   "cli-github-issues",
 		Short: "A command line utility for working with github issues",
 	}
-	cfgFile string
-	cfg     *config.Config
-	client  *github.Client
+	cfgFile   string
+	repoFlags []string
+	dryRun    bool
+	cfg       *config.Config
+	client    *github.Client
+
+	// cache flags
+	cacheTTL time.Duration
+	noCache  bool
+
+	// logging flags
+	verbose   bool
+	debug     bool
+	logFormat string
 )
 
 func Execute() {
-	cobra.CheckErr(rootCmd.Execute())
+	cobra.CheckErr(rootCmd.ExecuteContext(context.Background()))
 }
 
 func init() {
+	// run the hooks of every parent, so that subcommands with own hooks keep
+	// the token check
+	cobra.EnableTraverseRunHooks = true
+	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		checkRepo(cmd)
+		checkTokenScopes(cmd, args)
+	}
 	cobra.OnInitialize(func() {
 		// load config
 		cfg = config.MustLoad(cfgFile)
+		if cfg.Token == "" {
+			cfg.Token = mustStoredToken()
+		}
+		if len(repoFlags) > 0 {
+			applyRepoFlags()
+		} else if cfg.Repo == "" && len(cfg.Repos) > 0 {
+			// the first of github.repos stands in for a missing github.repo
+			repo, err := parseRepoRef(cfg.Repos[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			cfg.Owner, cfg.Repo = repo.owner, repo.name
+		}
 
 		// init GitHub client
+		var opts []github.ClientOption
+		if dryRun {
+			opts = append(opts, github.WithDryRun(os.Stderr))
+		}
+		if !noCache {
+			dir, err := github.DefaultCacheDir()
+			if err != nil {
+				log.Fatal(err)
+			}
+			opts = append(opts, github.WithCache(dir, cacheTTL))
+		}
+		if cfg.AppID != 0 {
+			opts = append(opts, mustAppAuth())
+		}
+		if logger := newLogger(); logger != nil {
+			opts = append(opts, github.WithLogger(logger))
+		}
+		// use an own http.Client, as NewClient replaces its transport
 		var err error
-		if client, err = github.NewClient(http.DefaultClient, cfg.Token); err != nil {
+		if client, err = github.NewClient(&http.Client{}, cfg.Token, opts...); err != nil {
 			log.Fatal(err)
 		}
 	})
@@ -39,19 +97,110 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/cli-github-issues.cobra.yaml)")
 	rootCmd.PersistentFlags().String("editor", "code", "issue editor")
 	rootCmd.PersistentFlags().String("owner", "", "owner of repository")
-	rootCmd.PersistentFlags().String("repo", "", "repository")
+	rootCmd.PersistentFlags().StringSliceVar(&repoFlags, "repo", nil, "repository, as repo or owner/repo; repeat it for commands working across repositories")
 	rootCmd.PersistentFlags().String("token", "", "GitHub token")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "print the requests that would change data instead of sending them")
+
+	rootCmd.PersistentFlags().DurationVar(&cacheTTL, "cache-ttl", 0, "serve cached responses younger than this without revalidating them")
+	rootCmd.PersistentFlags().BoolVar(&noCache, "no-cache", false, "do not cache responses")
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "log every HTTP request with its status, timing and rate limit")
+	rootCmd.PersistentFlags().BoolVar(&debug, "debug", false, "like --verbose, also logging headers and bodies")
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", "text", "log format: text or json")
 
 	// bind cli flags with viper
 	viper.BindPFlag("editor", rootCmd.PersistentFlags().Lookup("editor"))
 	viper.BindPFlag("github.owner", rootCmd.PersistentFlags().Lookup("owner"))
-	viper.BindPFlag("github.repo", rootCmd.PersistentFlags().Lookup("repo"))
 	viper.BindPFlag("github.token", rootCmd.PersistentFlags().Lookup("token"))
 }
 
+// newLogger returns the HTTP logger selected by the logging flags or the
+// HTTP_DEBUG environment variable, or nil if logging is off. HTTP_DEBUG=1
+// equals --debug and HTTP_DEBUG=json equals --debug --log-format json.
+func newLogger() *slog.Logger {
+	switch env := strings.ToLower(os.Getenv(envHTTPDebug)); env {
+	case "", "0", "false":
+	case "json":
+		debug, logFormat = true, "json"
+	default:
+		debug = true
+	}
+	if !verbose && !debug {
+		return nil
+	}
+
+	opts := &slog.HandlerOptions{Level: slog.LevelInfo}
+	if debug {
+		opts.Level = slog.LevelDebug
+	}
+	switch logFormat {
+	case "json":
+		return slog.New(slog.NewJSONHandler(os.Stderr, opts))
+	case "text":
+		return slog.New(slog.NewTextHandler(os.Stderr, opts))
+	default:
+		log.Fatalf("Invalid log format %q, expected text or json", logFormat)
+		return nil
+	}
+}
+
+// applyRepoFlags makes the --repo values override the configured
+// repositories, the first one becoming the default repository.
+func applyRepoFlags() {
+	repos := make([]string, 0, len(repoFlags))
+	for _, s := range repoFlags {
+		repo, err := parseRepoRef(s)
+		if err != nil {
+			log.Fatal(err)
+		}
+		repos = append(repos, repo.String())
+	}
+	cfg.Repos = repos
+	cfg.Owner, cfg.Repo, _ = parseRepo(repos[0])
+}
+
+// mustStoredToken returns the token saved by auth login in the configured
+// credential store.
+func mustStoredToken() string {
+	store, err := config.NewCredentialStore(cfg.Auth.CredentialStore)
+	if err != nil {
+		log.Fatal(err)
+	}
+	token, err := store.Token()
+	if err != nil {
+		log.Fatal(err)
+	}
+	return token
+}
+
+// mustAppAuth returns the client option authenticating as the configured
+// GitHub App installation.
+func mustAppAuth() github.ClientOption {
+	if cfg.InstallationID == 0 || cfg.PrivateKeyPath == "" {
+		log.Fatal("github.installation_id and github.private_key_path are required with github.app_id")
+	}
+	data, err := os.ReadFile(cfg.PrivateKeyPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	key, err := github.ParsePrivateKey(data)
+	if err != nil {
+		log.Fatalf("%s: %s", cfg.PrivateKeyPath, err)
+	}
+	return github.WithAppAuth(cfg.AppID, cfg.InstallationID, key)
+}
+
 func flagMustExist[T any](v T, err error) T {
 	if err != nil {
 		log.Fatal(err)
 	}
 	return v
 }
+
+// parseRepo splits an "owner/repo" name into its parts.
+func parseRepo(name string) (owner string, repo string, err error) {
+	owner, repo, ok := strings.Cut(name, "/")
+	if !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return "", "", fmt.Errorf("invalid repository %q, expected owner/repo", name)
+	}
+	return owner, repo, nil
+}
@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	// scopeQualifierRegexp matches qualifiers that already limit the search scope.
+	scopeQualifierRegexp = regexp.MustCompile(`(^|\s)-?(repo|org|user):`)
+
+	// typeQualifierRegexp matches qualifiers that already pick issues or pull requests.
+	typeQualifierRegexp = regexp.MustCompile(`(^|\s)(is:(issue|pr|pull-request)|type:)`)
+)
+
+var searchCmd = &cobra.Command{
+	Use:   "search [query]",
+	Short: "Search issues with the GitHub search syntax",
+	Long: `Search issues with the GitHub search syntax.
+
+The query is combined with qualifiers compiled from the flags. Unless the
+query or --org sets a scope, the search is limited to the configured
+repositories: those given with --repo, listed under github.repos in config,
+or the single configured repository.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// compile query from cli
+		query := buildSearchQuery(cmd, strings.Join(args, " "))
+		limit := mustLimit(cmd)
+		opts := &github.SearchOptions{
+			Sort:        flagMustExist(cmd.Flags().GetString("sort")),
+			Order:       flagMustExist(cmd.Flags().GetString("order")),
+			ListOptions: github.ListOptions{PerPage: min(limit, 100)},
+		}
+
+		// search mirrored issues
+		if flagMustExist(cmd.Flags().GetBool("offline")) {
+			m := mustOpenMirror()
+			defer m.Close()
+			repos, err := m.Repos()
+			if err != nil {
+				log.Fatal(err)
+			}
+			issues := mustOfflineIssues(m, repos, query)
+			sortIssues(issues, opts.Sort, opts.Order)
+			fmt.Fprintf(os.Stderr, "Showing %d of %d results for %q\n", min(len(issues), limit), len(issues), query)
+			printIssueTable(issues[:min(len(issues), limit)], tableOptions{repo: true, reactions: isReactionSort(opts.Sort)})
+			return
+		}
+
+		// search issues
+		issues, total := mustSearchIssues(cmd.Context(), query, opts, limit)
+
+		// print result
+		fmt.Fprintf(os.Stderr, "Showing %d of %d results for %q\n", len(issues), total, query)
+		printIssueTable(issues, tableOptions{repo: true, reactions: isReactionSort(opts.Sort)})
+	},
+}
+
+// mustSearchIssues returns up to limit issues matching query and the total
+// number of matches, following pagination.
+func mustSearchIssues(ctx context.Context, query string, opts *github.SearchOptions, limit int) ([]*github.Issue, int) {
+	var (
+		issues []*github.Issue
+		total  int
+	)
+	for len(issues) < limit {
+		result, resp, err := client.Search.Issues(ctx, query, opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+		if result.GetIncompleteResults() {
+			fmt.Fprintln(os.Stderr, "warning: search timed out, results are incomplete")
+		}
+		total = result.GetTotal()
+		issues = append(issues, result.Issues[:min(len(result.Issues), limit-len(issues))]...)
+
+		if opts.Page = github.NextPage(resp); opts.Page == 0 {
+			break
+		}
+	}
+	return issues, total
+}
+
+// mustLimit returns the --limit flag of cmd, exiting if it is negative.
+func mustLimit(cmd *cobra.Command) int {
+	limit := flagMustExist(cmd.Flags().GetInt("limit"))
+	if limit < 0 {
+		log.Fatalf("Invalid limit %d, expected a number of at least 0", limit)
+	}
+	return limit
+}
+
+// buildSearchQuery appends the qualifiers set through flags to the raw query.
+func buildSearchQuery(cmd *cobra.Command, raw string) string {
+	terms := []string{}
+	if raw != "" {
+		terms = append(terms, raw)
+	}
+
+	// scope and type defaults
+	org := flagMustExist(cmd.Flags().GetString("org"))
+	switch {
+	case org != "":
+		terms = append(terms, github.Qualifier("org", org))
+	case !scopeQualifierRegexp.MatchString(raw) && (len(cfg.Repos) > 0 || cfg.Owner != "" && cfg.Repo != ""):
+		terms = append(terms, repoQualifiers(configuredRepos())...)
+	}
+	if !typeQualifierRegexp.MatchString(raw) {
+		terms = append(terms, "is:issue")
+	}
+
+	// single value qualifiers
+	for _, q := range []struct{ flag, key string }{
+		{"author", "author"},
+		{"assignee", "assignee"},
+		{"state", "state"},
+		{"in", "in"},
+		{"created", "created"},
+		{"updated", "updated"},
+		{"closed", "closed"},
+		{"milestone", "milestone"},
+	} {
+		if v := flagMustExist(cmd.Flags().GetString(q.flag)); v != "" {
+			terms = append(terms, github.Qualifier(q.key, v))
+		}
+	}
+	for _, label := range flagMustExist(cmd.Flags().GetStringSlice("label")) {
+		terms = append(terms, github.Qualifier("label", label))
+	}
+
+	return strings.Join(terms, " ")
+}
+
+func init() {
+	rootCmd.AddCommand(searchCmd)
+
+	// set qualifier flags
+	searchCmd.Flags().String("org", "", "search the repositories of an organization")
+	searchCmd.Flags().String("author", "", "filter by author")
+	searchCmd.Flags().String("assignee", "", "filter by assignee")
+	searchCmd.Flags().StringSlice("label", nil, "filter by label names")
+	searchCmd.Flags().String("state", "", "filter by state: open or closed")
+	searchCmd.Flags().String("in", "", "restrict terms to fields, e.g. title,body")
+	searchCmd.Flags().String("created", "", "filter by creation date, e.g. \">=2026-01-01\"")
+	searchCmd.Flags().String("updated", "", "filter by update date")
+	searchCmd.Flags().String("closed", "", "filter by close date")
+	searchCmd.Flags().String("milestone", "", "filter by milestone title")
+
+	// set result flags
+	searchCmd.Flags().String("sort", "", "sort by comments, reactions, one reaction such as reactions-+1, created or updated")
+	searchCmd.Flags().String("order", "", "sort order: asc or desc")
+	searchCmd.Flags().Int("limit", 30, "maximum number of results")
+	searchCmd.Flags().Bool("offline", false, "search the local mirror updated by sync")
+}
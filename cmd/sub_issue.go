@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/spf13/cobra"
+)
+
+var subIssueCmd = &cobra.Command{
+	Use:   "sub-issue",
+	Short: "Manage the sub-issues of an issue",
+}
+
+var subIssueAddCmd = &cobra.Command{
+	Use:         "add",
+	Short:       "Add a sub-issue to an issue",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get sub-issue params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		child := mustGetIssue(flagMustExist(cmd.Flags().GetInt("child")))
+		req := &github.SubIssueRequest{SubIssueID: child.GetID()}
+		if flagMustExist(cmd.Flags().GetBool("replace-parent")) {
+			req.ReplaceParent = github.Bool(true)
+		}
+
+		// add sub-issue
+		parent, resp, err := client.Issues.AddSubIssue(cmd.Context(), cfg.Owner, cfg.Repo, number, req)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// check and print result
+		if resp.StatusCode == http.StatusCreated {
+			printSubIssuesSummary(parent)
+		} else {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+	},
+}
+
+var subIssueRemoveCmd = &cobra.Command{
+	Use:         "remove",
+	Short:       "Remove a sub-issue from an issue",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get sub-issue params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		child := mustGetIssue(flagMustExist(cmd.Flags().GetInt("child")))
+
+		// remove sub-issue
+		req := &github.SubIssueRequest{SubIssueID: child.GetID()}
+		parent, resp, err := client.Issues.RemoveSubIssue(cmd.Context(), cfg.Owner, cfg.Repo, number, req)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// check and print result
+		if resp.StatusCode == http.StatusOK || github.IsDryRun(resp) {
+			printSubIssuesSummary(parent)
+		} else {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+	},
+}
+
+var subIssueReprioritizeCmd = &cobra.Command{
+	Use:         "reprioritize",
+	Short:       "Move a sub-issue before or after another sub-issue",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get sub-issue params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		child := mustGetIssue(flagMustExist(cmd.Flags().GetInt("child")))
+		req := &github.SubIssueRequest{SubIssueID: child.GetID()}
+		if after := flagMustExist(cmd.Flags().GetInt("after")); after != 0 {
+			req.AfterID = github.Int64(mustGetIssue(after).GetID())
+		}
+		if before := flagMustExist(cmd.Flags().GetInt("before")); before != 0 {
+			req.BeforeID = github.Int64(mustGetIssue(before).GetID())
+		}
+		if req.AfterID == nil && req.BeforeID == nil {
+			log.Fatal("one of --after or --before is required")
+		}
+
+		// reprioritize sub-issue
+		parent, resp, err := client.Issues.ReprioritizeSubIssue(cmd.Context(), cfg.Owner, cfg.Repo, number, req)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// check and print result
+		if resp.StatusCode == http.StatusOK {
+			printSubIssuesSummary(parent)
+		} else {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+	},
+}
+
+// printSubIssuesSummary prints the sub-issue progress of the parent issue.
+func printSubIssuesSummary(parent *github.Issue) {
+	summary := parent.GetSubIssuesSummary()
+	fmt.Printf("#%-5d %d/%d sub-issues completed (%d%%)\n",
+		parent.GetNumber(), summary.GetCompleted(), summary.GetTotal(), summary.GetPercentCompleted())
+}
+
+func init() {
+	rootCmd.AddCommand(subIssueCmd)
+	subIssueCmd.AddCommand(subIssueAddCmd, subIssueRemoveCmd, subIssueReprioritizeCmd)
+
+	// set required flags
+	for _, c := range []*cobra.Command{subIssueAddCmd, subIssueRemoveCmd, subIssueReprioritizeCmd} {
+		c.Flags().Int("number", 0, "issue number of the parent")
+		c.Flags().Int("child", 0, "issue number of the sub-issue")
+		c.MarkFlagRequired("number")
+		c.MarkFlagRequired("child")
+	}
+
+	// set optional flags
+	subIssueAddCmd.Flags().Bool("replace-parent", false, "move the sub-issue away from its current parent")
+	subIssueReprioritizeCmd.Flags().Int("after", 0, "issue number of the sub-issue to move after")
+	subIssueReprioritizeCmd.Flags().Int("before", 0, "issue number of the sub-issue to move before")
+	subIssueReprioritizeCmd.MarkFlagsMutuallyExclusive("after", "before")
+}
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"cli-github-issues/internal/mirror"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Mirror issues, comments and labels for offline use",
+	Long: `Mirror the issues, comments and labels of the configured repositories into
+a local database, so that list, view and search can run with --offline.
+
+After the first run only issues and comments updated since the previous sync
+are fetched; --full fetches everything again.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// get sync params from cli
+		full := flagMustExist(cmd.Flags().GetBool("full"))
+
+		m := mustOpenMirror()
+		defer m.Close()
+
+		// sync repositories one after another, they share the database
+		failed := false
+		for _, repo := range configuredRepos() {
+			stats, err := syncRepo(cmd.Context(), m, repo, full)
+			if err != nil {
+				failed = true
+				fmt.Fprintf(os.Stderr, "%s: %s\n", repo, err)
+				continue
+			}
+			fmt.Printf("%s: %d issues, %d comments, %d labels\n", repo, stats.issues, stats.comments, stats.labels)
+		}
+		if failed {
+			os.Exit(1)
+		}
+	},
+}
+
+// syncMargin is subtracted from the start of a sync to get the since time of
+// the next one, covering clock skew and updates GitHub was still writing.
+const syncMargin = 5 * time.Minute
+
+// syncStats counts what a sync fetched.
+type syncStats struct {
+	issues   int
+	comments int
+	labels   int
+}
+
+// syncRepo fetches the issues and comments of repo updated since its last
+// sync, and all its labels, into m.
+func syncRepo(ctx context.Context, m *mirror.Mirror, repo repoRef, full bool) (syncStats, error) {
+	var stats syncStats
+	state, err := m.SyncState(repo.String())
+	if err != nil {
+		return stats, err
+	}
+	if full {
+		state.Since = time.Time{}
+	}
+	start := time.Now()
+	since := state.Since
+
+	// sync issues, oldest update first
+	issueOpts := &github.IssueListByRepoOptions{
+		State:       "all",
+		Sort:        "updated",
+		Direction:   "asc",
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		page, resp, err := client.Issues.ListByRepo(ctx, repo.owner, repo.name, issueOpts)
+		if err != nil {
+			return stats, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return stats, fmt.Errorf("invalid status code: %d", resp.StatusCode)
+		}
+		var issues []*github.Issue
+		for _, issue := range page {
+			if !issue.IsPullRequest() {
+				issues = append(issues, issue)
+			}
+		}
+		if err := m.PutIssues(repo.String(), issues); err != nil {
+			return stats, err
+		}
+		stats.issues += len(issues)
+
+		if issueOpts.Page = github.NextPage(resp); issueOpts.Page == 0 {
+			break
+		}
+	}
+
+	// sync comments of all issues at once
+	commentOpts := &github.IssueListRepositoryCommentsOptions{
+		Sort:        "updated",
+		Direction:   "asc",
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		page, resp, err := client.Issues.ListRepositoryComments(ctx, repo.owner, repo.name, commentOpts)
+		if err != nil {
+			return stats, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return stats, fmt.Errorf("invalid status code: %d", resp.StatusCode)
+		}
+		if err := m.PutComments(repo.String(), page); err != nil {
+			return stats, err
+		}
+		stats.comments += len(page)
+
+		if commentOpts.Page = github.NextPage(resp); commentOpts.Page == 0 {
+			break
+		}
+	}
+
+	// sync labels, which have no update time
+	var labels []*github.Label
+	labelOpts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := client.Issues.ListLabels(ctx, repo.owner, repo.name, labelOpts)
+		if err != nil {
+			return stats, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return stats, fmt.Errorf("invalid status code: %d", resp.StatusCode)
+		}
+		labels = append(labels, page...)
+
+		if labelOpts.Page = github.NextPage(resp); labelOpts.Page == 0 {
+			break
+		}
+	}
+	if err := m.PutLabels(repo.String(), labels); err != nil {
+		return stats, err
+	}
+	stats.labels = len(labels)
+
+	// the next sync starts before this one did rather than at the latest
+	// update seen, which may be a comment newer than unlisted issue updates
+	state.LastSync = start
+	state.Since = start.Add(-syncMargin)
+	return stats, m.SetSyncState(repo.String(), state)
+}
+
+// mustOpenMirror opens the local mirror database.
+func mustOpenMirror() *mirror.Mirror {
+	path, err := mirror.DefaultPath()
+	if err != nil {
+		log.Fatal(err)
+	}
+	m, err := mirror.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return m
+}
+
+func init() {
+	rootCmd.AddCommand(syncCmd)
+
+	// set optional flags
+	syncCmd.Flags().Bool("full", false, "fetch everything instead of only what changed since the last sync")
+}
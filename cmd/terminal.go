@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/ansi"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+const (
+	defaultPager = "less -R"
+	defaultWidth = 80
+)
+
+// stdoutIsTerminal reports whether stdout is attached to a terminal.
+func stdoutIsTerminal() bool {
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// newPainter returns a painter that colors output only for terminals,
+// honouring the NO_COLOR convention.
+func newPainter() *ansi.Painter {
+	_, noColor := os.LookupEnv("NO_COLOR")
+	return ansi.NewPainter(stdoutIsTerminal() && !noColor)
+}
+
+// terminalSize returns the size of the terminal attached to stdout, falling
+// back to defaultWidth columns and no height limit.
+func terminalSize() (width int, height int) {
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 {
+		return defaultWidth, 0
+	}
+	return width, height
+}
+
+// printPaged prints text to stdout, piping it through $PAGER when stdout is a
+// terminal and text does not fit on one screen.
+func printPaged(text string) {
+	_, height := terminalSize()
+	if !stdoutIsTerminal() || height == 0 || strings.Count(text, "\n") < height {
+		fmt.Print(text)
+		return
+	}
+
+	pager := os.Getenv("PAGER")
+	if pager == "" {
+		pager = defaultPager
+	}
+	args := strings.Fields(pager)
+	if len(args) == 0 || args[0] == "cat" {
+		fmt.Print(text)
+		return
+	}
+
+	// run pager with text as its input
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	if os.Getenv("LESS") == "" {
+		cmd.Env = append(os.Environ(), "LESS=FRX")
+	}
+	if err := cmd.Run(); err != nil {
+		fmt.Print(text)
+	}
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/github"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// maxSearchResults is the maximum number of results the Search API returns for a query.
+const maxSearchResults = 1000
+
+var transferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "Transfer issues to another repository",
+	Long: `Transfer issues to another repository.
+
+Labels are kept where the target repository has labels with matching names;
+--create-labels creates the missing ones. Use --all-matching with a search
+query to move every matching issue of the configured repository.`,
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get transfer params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		filter := flagMustExist(cmd.Flags().GetString("all-matching"))
+		createLabels := flagMustExist(cmd.Flags().GetBool("create-labels"))
+		targetOwner, targetRepo, err := parseRepo(flagMustExist(cmd.Flags().GetString("to")))
+		if err != nil {
+			log.Fatal(err)
+		}
+		if (number == 0) == (filter == "") {
+			log.Fatal("exactly one of --number or --all-matching is required")
+		}
+
+		// resolve target repository
+		target, resp, err := client.Repositories.Get(cmd.Context(), targetOwner, targetRepo)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+
+		// collect issues to transfer
+		var issues []*github.Issue
+		if number != 0 {
+			issues = append(issues, mustGetIssue(number))
+		} else {
+			query := fmt.Sprintf("%s %s is:issue", filter, github.Qualifier("repo", cfg.Owner+"/"+cfg.Repo))
+			opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}}
+			issues, _ = mustSearchIssues(cmd.Context(), query, opts, maxSearchResults)
+		}
+
+		// transfer issues one by one, reporting failures at the end
+		failed := 0
+		for _, issue := range issues {
+			moved, resp, err := client.Issues.Transfer(cmd.Context(), issue.GetNodeID(), target.GetNodeID(), createLabels)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "#%-5d %v\n", issue.GetNumber(), err)
+				failed++
+				continue
+			}
+			if github.IsDryRun(resp.Response) {
+				fmt.Printf("#%-5d -> %s (dry run)\n", issue.GetNumber(), target.GetFullName())
+				continue
+			}
+			if moved == nil {
+				log.Fatalf("GitHub did not return the transferred issue #%d", issue.GetNumber())
+			}
+			fmt.Printf("#%-5d -> %s#%d %s\n", issue.GetNumber(), target.GetFullName(), moved.Number, moved.URL)
+		}
+		if failed > 0 {
+			log.Fatalf("%d of %d issues were not transferred", failed, len(issues))
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(transferCmd)
+
+	// set required flag
+	transferCmd.Flags().String("to", "", "target repository as owner/repo")
+	transferCmd.MarkFlagRequired("to")
+
+	// set optional flags
+	transferCmd.Flags().Int("number", 0, "issue number")
+	transferCmd.Flags().String("all-matching", "", "search query selecting the issues to transfer")
+	transferCmd.Flags().Bool("create-labels", false, "create labels missing in the target repository")
+	transferCmd.MarkFlagsMutuallyExclusive("number", "all-matching")
+}
@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/ansi"
+	"cli-github-issues/internal/github"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/spf13/cobra"
+)
+
+// treeNode is an issue or plain task of an epic's hierarchy.
+type treeNode struct {
+	label    string
+	done     bool
+	isTask   bool
+	children []*treeNode
+}
+
+var treeCmd = &cobra.Command{
+	Use:   "tree",
+	Short: "Show the hierarchy of an epic with its completion state",
+	Long: `Show the hierarchy of an epic with its completion state.
+
+Children are the sub-issues of an issue followed by the items of the task
+lists in its body, e.g. "- [ ] #123" or "- [x] write docs". A referenced
+issue counts as done when it is closed or its task item is checked.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		// get tree params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		depth := flagMustExist(cmd.Flags().GetInt("depth"))
+
+		// build tree
+		b := &treeBuilder{ctx: cmd.Context(), visited: map[string]bool{}}
+		root := b.issueNode(cfg.Owner, cfg.Repo, mustGetIssue(number), depth)
+
+		// print result
+		painter := newPainter()
+		fmt.Println(formatTreeLabel(painter, root))
+		printTreeChildren(painter, root, "")
+	},
+}
+
+type treeBuilder struct {
+	ctx     context.Context
+	visited map[string]bool
+}
+
+// issueNode builds the node of an issue and, up to depth levels, of its
+// sub-issues and task list items.
+func (b *treeBuilder) issueNode(owner string, repo string, issue *github.Issue, depth int) *treeNode {
+	key := fmt.Sprintf("%s/%s#%d", owner, repo, issue.GetNumber())
+	node := &treeNode{label: issueRef(owner, repo, issue.GetNumber()) + " " + issue.GetTitle(), done: issue.GetState() == "closed"}
+	if b.visited[key] {
+		node.label += " (see above)"
+		return node
+	}
+	b.visited[key] = true
+	if depth <= 0 {
+		return node
+	}
+
+	// sub-issues come first, in their priority order
+	linked := map[string]bool{}
+	for _, sub := range b.subIssues(owner, repo, issue.GetNumber()) {
+		subOwner, subRepo, err := parseRepo(sub.RepositoryFullName())
+		if err != nil {
+			subOwner, subRepo = owner, repo
+		}
+		linked[fmt.Sprintf("%s/%s#%d", subOwner, subRepo, sub.GetNumber())] = true
+		node.children = append(node.children, b.issueNode(subOwner, subRepo, sub, depth-1))
+	}
+
+	// then the task list of the body
+	for _, item := range github.ParseTaskList(issue.GetBody()) {
+		if item.Number == 0 {
+			node.children = append(node.children, &treeNode{label: item.Text, done: item.Checked, isTask: true})
+			continue
+		}
+
+		refOwner, refRepo := item.Owner, item.Repo
+		if refOwner == "" {
+			refOwner, refRepo = owner, repo
+		}
+		if linked[fmt.Sprintf("%s/%s#%d", refOwner, refRepo, item.Number)] {
+			continue
+		}
+		ref, resp, err := client.Issues.Get(refOwner, refRepo, item.Number)
+		if err != nil || resp.StatusCode != http.StatusOK {
+			node.children = append(node.children, &treeNode{label: item.Text + " (not accessible)", done: item.Checked, isTask: true})
+			continue
+		}
+		child := b.issueNode(refOwner, refRepo, ref, depth-1)
+		child.done = child.done || item.Checked
+		node.children = append(node.children, child)
+	}
+	return node
+}
+
+// subIssues returns every sub-issue of the issue.
+func (b *treeBuilder) subIssues(owner string, repo string, number int) []*github.Issue {
+	var all []*github.Issue
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		issues, resp, err := client.Issues.ListSubIssues(b.ctx, owner, repo, number, opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+		all = append(all, issues...)
+
+		if opts.Page = github.NextPage(resp); opts.Page == 0 {
+			return all
+		}
+	}
+}
+
+// issueRef formats an issue reference, omitting the configured repository.
+func issueRef(owner string, repo string, number int) string {
+	if owner == cfg.Owner && repo == cfg.Repo {
+		return fmt.Sprintf("#%d", number)
+	}
+	return fmt.Sprintf("%s/%s#%d", owner, repo, number)
+}
+
+func formatTreeLabel(painter *ansi.Painter, node *treeNode) string {
+	var mark string
+	switch {
+	case node.isTask && node.done:
+		mark = painter.Paint("☑", ansi.Green)
+	case node.isTask:
+		mark = "☐"
+	case node.done:
+		mark = painter.Paint("✓", ansi.Magenta)
+	default:
+		mark = painter.Paint("○", ansi.Green)
+	}
+
+	label := mark + " " + node.label
+	if len(node.children) > 0 {
+		done := 0
+		for _, c := range node.children {
+			if c.done {
+				done++
+			}
+		}
+		label += painter.Paint(fmt.Sprintf(" [%d/%d]", done, len(node.children)), ansi.Gray)
+	}
+	return label
+}
+
+func printTreeChildren(painter *ansi.Painter, node *treeNode, indent string) {
+	for i, child := range node.children {
+		branch, next := "├── ", "│   "
+		if i == len(node.children)-1 {
+			branch, next = "└── ", "    "
+		}
+		fmt.Println(indent + painter.Paint(branch, ansi.Gray) + formatTreeLabel(painter, child))
+		printTreeChildren(painter, child, indent+painter.Paint(next, ansi.Gray))
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(treeCmd)
+
+	// set required flag
+	treeCmd.Flags().Int("number", 0, "issue number of the epic")
+	treeCmd.MarkFlagRequired("number")
+
+	// set optional flags
+	treeCmd.Flags().Int("depth", 3, "maximum depth of the hierarchy")
+}
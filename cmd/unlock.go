@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/spf13/cobra"
+)
+
+var unlockCmd = &cobra.Command{
+	Use:         "unlock",
+	Short:       "Unlock the conversation of an issue on the specified repository",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get issue number from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+
+		// unlock issue
+		resp, err := client.Issues.Unlock(cmd.Context(), cfg.Owner, cfg.Repo, number)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// check and print result
+		if resp.StatusCode == http.StatusNoContent {
+			printLockState(mustGetIssue(number))
+		} else {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(unlockCmd)
+
+	// set required flag
+	unlockCmd.Flags().Int("number", 0, "issue number")
+	unlockCmd.MarkFlagRequired("number")
+}
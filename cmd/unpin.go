@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+)
+
+var unpinCmd = &cobra.Command{
+	Use:         "unpin",
+	Short:       "Unpin an issue from the issue list of the specified repository",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		// get issue number from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+
+		// unpin issue by its node id
+		issue := mustGetIssue(number)
+		if _, err := client.Issues.Unpin(cmd.Context(), issue.GetNodeID()); err != nil {
+			log.Fatal(err)
+		}
+
+		// print result
+		fmt.Printf("#%-5d unpinned\n", issue.GetNumber())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(unpinCmd)
+
+	// set required flag
+	unpinCmd.Flags().Int("number", 0, "issue number")
+	unpinCmd.MarkFlagRequired("number")
+}
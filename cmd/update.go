@@ -3,15 +3,16 @@ package cmd
 import (
 	"cli-github-issues/internal/editor"
 	"cli-github-issues/internal/github"
-	"fmt"
+	"cli-github-issues/internal/mirror"
 	"github.com/spf13/cobra"
 	"log"
 	"net/http"
 )
 
 var updateCmd = &cobra.Command{
-	Use:   "update",
-	Short: "Update an issue on the specified repository",
+	Use:         "update",
+	Short:       "Update an issue on the specified repository",
+	Annotations: map[string]string{annotationScopes: "public_repo"},
 	Run: func(cmd *cobra.Command, args []string) {
 		// get issue request params
 		number := flagMustExist(cmd.Flags().GetInt("number"))
@@ -26,6 +27,10 @@ var updateCmd = &cobra.Command{
 			Title: &title,
 			Body:  github.String(string(bodyData)),
 		}
+		if flagMustExist(cmd.Flags().GetBool("offline")) {
+			mustEnqueue(&mirror.Change{Kind: mirror.ChangeUpdate, Number: number, Request: editedIssue})
+			return
+		}
 		issue, resp, err := client.Issues.Update(cfg.Owner, cfg.Repo, number, editedIssue)
 		if err != nil {
 			log.Fatal(err)
@@ -33,7 +38,7 @@ var updateCmd = &cobra.Command{
 
 		// check and print result
 		if resp.StatusCode == http.StatusOK {
-			fmt.Printf("#%-5d %9.9s %.55s %q\n", *issue.Number, *issue.User.Login, *issue.Title, *issue.Body)
+			printIssue(issue)
 		} else {
 			log.Fatalf("Invalid status code: %d", resp.StatusCode)
 		}
@@ -49,4 +54,7 @@ func init() {
 
 	updateCmd.MarkFlagRequired("title")
 	updateCmd.MarkFlagRequired("number")
+
+	// set optional flags
+	updateCmd.Flags().Bool("offline", false, "queue the update to be sent by sync push")
 }
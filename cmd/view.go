@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"cli-github-issues/internal/ansi"
+	"cli-github-issues/internal/github"
+	"cli-github-issues/internal/markdown"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const timeLayout = "2006-01-02 15:04"
+
+var viewCmd = &cobra.Command{
+	Use:   "view",
+	Short: "View an issue with its rendered body and metadata",
+	Run: func(cmd *cobra.Command, args []string) {
+		// get view params from cli
+		number := flagMustExist(cmd.Flags().GetInt("number"))
+		withComments := flagMustExist(cmd.Flags().GetBool("comments"))
+
+		// get issue and comment thread
+		var (
+			issue    *github.Issue
+			comments []*github.IssueComment
+		)
+		if flagMustExist(cmd.Flags().GetBool("offline")) {
+			m := mustOpenMirror()
+			issue, comments = mustOfflineIssue(m, number, withComments)
+			m.Close()
+		} else {
+			var resp *http.Response
+			var err error
+			issue, resp, err = client.Issues.Get(cfg.Owner, cfg.Repo, number)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				log.Fatalf("Invalid status code: %d", resp.StatusCode)
+			}
+			if withComments {
+				comments = mustListComments(number)
+			}
+		}
+
+		// render and print result
+		width, _ := terminalSize()
+		painter := newPainter()
+		renderer := markdown.NewRenderer(width, painter)
+
+		var b strings.Builder
+		writeIssueHeader(&b, painter, issue)
+		b.WriteString("\n")
+		if body := issue.GetBody(); body != "" {
+			b.WriteString(renderer.Render(body))
+		} else {
+			b.WriteString(painter.Paint("No description provided.", ansi.Gray, ansi.Italic) + "\n")
+		}
+		for _, c := range comments {
+			b.WriteString("\n" + painter.Paint(strings.Repeat("─", min(width, 80)), ansi.Gray) + "\n")
+			fmt.Fprintf(&b, "%s commented %s\n\n",
+				painter.Paint(c.GetUser().GetLogin(), ansi.Bold),
+				painter.Paint(formatTime(c.GetCreatedAt()), ansi.Gray),
+			)
+			b.WriteString(renderer.Render(c.GetBody()))
+		}
+		if !withComments && issue.GetComments() > 0 {
+			b.WriteString("\n" + painter.Paint(fmt.Sprintf("%d comments, use --comments to show them", issue.GetComments()), ansi.Gray) + "\n")
+		}
+		b.WriteString("\n" + painter.Paint(issue.GetHTMLURL(), ansi.Gray) + "\n")
+
+		printPaged(b.String())
+	},
+}
+
+// writeIssueHeader writes the title, state badge and metadata of the issue.
+func writeIssueHeader(b *strings.Builder, painter *ansi.Painter, issue *github.Issue) {
+	fmt.Fprintf(b, "%s %s\n", painter.Paint(issue.GetTitle(), ansi.Bold), painter.Paint(fmt.Sprintf("#%d", issue.GetNumber()), ansi.Gray))
+	fmt.Fprintf(b, "%s • %s opened %s • %d comments\n",
+		stateBadge(painter, issue),
+		issue.GetUser().GetLogin(),
+		formatTime(issue.GetCreatedAt()),
+		issue.GetComments(),
+	)
+
+	if names := issue.LabelNames(); len(names) > 0 {
+		fmt.Fprintf(b, "Labels:    %s\n", strings.Join(names, ", "))
+	}
+	if logins := issue.AssigneeLogins(); len(logins) > 0 {
+		fmt.Fprintf(b, "Assignees: %s\n", strings.Join(logins, ", "))
+	}
+	if m := issue.GetMilestone(); m != nil {
+		fmt.Fprintf(b, "Milestone: %s\n", m.GetTitle())
+	}
+	if t := issue.GetUpdatedAt(); !t.IsZero() {
+		fmt.Fprintf(b, "Updated:   %s\n", formatTime(t))
+	}
+	if t := issue.GetClosedAt(); !t.IsZero() {
+		closed := formatTime(t)
+		if login := issue.GetClosedBy().GetLogin(); login != "" {
+			closed += " by " + login
+		}
+		fmt.Fprintf(b, "Closed:    %s\n", closed)
+	}
+	if issue.GetLocked() {
+		fmt.Fprintf(b, "Locked:    %s\n", strings.TrimSpace("yes "+issue.GetActiveLockReason()))
+	}
+}
+
+// stateBadge returns the colored state of the issue, e.g. "Closed (not planned)".
+func stateBadge(painter *ansi.Painter, issue *github.Issue) string {
+	switch issue.GetState() {
+	case "open":
+		return painter.Paint("Open", ansi.Bold, ansi.Green)
+	case "closed":
+		badge, color := "Closed", ansi.Magenta
+		if reason := issue.GetStateReason(); reason != "" && reason != "completed" {
+			badge += " (" + strings.ReplaceAll(reason, "_", " ") + ")"
+			color = ansi.Gray
+		}
+		return painter.Paint(badge, ansi.Bold, color)
+	default:
+		return painter.Paint(issue.GetState(), ansi.Bold)
+	}
+}
+
+// mustListComments returns every comment of the issue, following pagination.
+func mustListComments(number int) []*github.IssueComment {
+	var all []*github.IssueComment
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		comments, resp, err := client.Issues.ListComments(cfg.Owner, cfg.Repo, number, opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("Invalid status code: %d", resp.StatusCode)
+		}
+		all = append(all, comments...)
+
+		if opts.Page = github.NextPage(resp); opts.Page == 0 {
+			return all
+		}
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format(timeLayout)
+}
+
+func init() {
+	rootCmd.AddCommand(viewCmd)
+
+	// set required flag
+	viewCmd.Flags().Int("number", 0, "issue number")
+	viewCmd.MarkFlagRequired("number")
+
+	// set optional flags
+	viewCmd.Flags().Bool("comments", false, "show the comment thread")
+	viewCmd.Flags().Bool("offline", false, "view the issue from the local mirror updated by sync")
+}
@@ -6,6 +6,8 @@ require (
 	github.com/google/go-cmp v0.5.9
 	github.com/spf13/cobra v1.8.0
 	github.com/spf13/viper v1.18.2
+	go.etcd.io/bbolt v1.3.10
+	golang.org/x/term v0.15.0
 )
 
 require (
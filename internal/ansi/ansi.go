@@ -0,0 +1,51 @@
+package ansi
+
+import "strings"
+
+// Code is an SGR parameter of an ANSI escape sequence.
+type Code string
+
+const (
+	Bold      Code = "1"
+	Dim       Code = "2"
+	Italic    Code = "3"
+	Underline Code = "4"
+	Strike    Code = "9"
+	Red       Code = "31"
+	Green     Code = "32"
+	Yellow    Code = "33"
+	Blue      Code = "34"
+	Magenta   Code = "35"
+	Cyan      Code = "36"
+	Gray      Code = "90"
+
+	reset = "\x1b[0m"
+)
+
+type Painter struct {
+	enabled bool
+}
+
+// NewPainter creates a new instance of the Painter. A disabled painter returns
+// its input unchanged.
+func NewPainter(enabled bool) *Painter {
+	return &Painter{enabled}
+}
+
+// Enabled reports whether the painter emits escape sequences.
+func (p *Painter) Enabled() bool {
+	return p.enabled
+}
+
+// Paint wraps s in the escape sequence for codes.
+func (p *Painter) Paint(s string, codes ...Code) string {
+	if !p.enabled || len(codes) == 0 || s == "" {
+		return s
+	}
+
+	params := make([]string, len(codes))
+	for i, c := range codes {
+		params[i] = string(c)
+	}
+	return "\x1b[" + strings.Join(params, ";") + "m" + s + reset
+}
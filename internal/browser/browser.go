@@ -0,0 +1,54 @@
+package browser
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+type Browser struct {
+	command []string
+}
+
+// NewBrowser creates a new instance of the Browser. The program is taken from
+// $BROWSER, falling back to the platform opener such as xdg-open.
+func NewBrowser() (*Browser, error) {
+	const op = "browser.NewBrowser"
+
+	// pick browser program
+	command := strings.Fields(os.Getenv("BROWSER"))
+	if len(command) == 0 {
+		switch runtime.GOOS {
+		case "darwin":
+			command = []string{"open"}
+		case "windows":
+			command = []string{"rundll32", "url.dll,FileProtocolHandler"}
+		default:
+			command = []string{"xdg-open"}
+		}
+	}
+
+	// find browser program in PATH
+	path, err := exec.LookPath(command[0])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	command[0] = path
+
+	return &Browser{command}, nil
+}
+
+// Open opens url in the browser.
+func (b *Browser) Open(url string) error {
+	const op = "browser.Open"
+
+	// run browser with url
+	cmd := exec.Command(b.command[0], append(b.command[1:], url)...)
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
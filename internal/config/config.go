@@ -1,24 +1,50 @@
 package config
 
 import (
+	"errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"io/fs"
 	"log"
 	"os"
+	"path/filepath"
 )
 
 type Config struct {
-	Editor string `mapstructure:"editor"`
-	Github `mapstructure:"github"`
+	Editor   string            `mapstructure:"editor"`
+	Searches map[string]string `mapstructure:"searches"`
+	Github   `mapstructure:"github"`
+	Auth     Auth `mapstructure:"auth"`
 }
 
 type Github struct {
 	Owner string `mapstructure:"owner"`
 	Repo  string `mapstructure:"repo"`
 	Token string `mapstructure:"token"`
+
+	// Repos lists the repositories, as owner/repo or repo of Owner, that
+	// commands working across repositories use by default
+	Repos []string `mapstructure:"repos"`
+
+	// GitHub App authentication, used instead of Token when AppID is set
+	AppID          int64  `mapstructure:"app_id"`
+	InstallationID int64  `mapstructure:"installation_id"`
+	PrivateKeyPath string `mapstructure:"private_key_path"`
+}
+
+type Auth struct {
+	ClientID        string   `mapstructure:"client_id"`
+	Scopes          []string `mapstructure:"scopes"`
+	CredentialStore string   `mapstructure:"credential_store"`
+
+	// ExpiryWarningDays is how many days before its expiration a token is
+	// warned about.
+	ExpiryWarningDays int `mapstructure:"expiry_warning_days"`
 }
 
-// MustLoad loads config file and returns config struct.
+// MustLoad loads config file and returns config struct. A missing config
+// file leaves the config empty, so that auth login can run before there is
+// one; it is then created at the default path when a token is stored in it.
 func MustLoad(cfgFile string) *Config {
 	if cfgFile != "" {
 		// Use config file from the flag.
@@ -32,11 +58,16 @@ func MustLoad(cfgFile string) *Config {
 		viper.AddConfigPath(home)
 		viper.SetConfigType("yaml")
 		viper.SetConfigName("cli-github-issues.cobra")
+		cfgFile = filepath.Join(home, "cli-github-issues.cobra.yaml")
 	}
 
 	// Read the config file
 	if err := viper.ReadInConfig(); err != nil {
-		log.Fatalf("cannot read config: %s", err)
+		var notFound viper.ConfigFileNotFoundError
+		if !errors.As(err, &notFound) && !errors.Is(err, fs.ErrNotExist) {
+			log.Fatalf("cannot read config: %s", err)
+		}
+		viper.SetConfigFile(cfgFile)
 	}
 
 	// Unmarshal config
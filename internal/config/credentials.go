@@ -0,0 +1,95 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	CredentialStoreConfig = "config"
+	CredentialStoreFile   = "file"
+)
+
+// CredentialStore persists the token obtained by auth login.
+type CredentialStore interface {
+	// Token returns the stored token, or "" if there is none.
+	Token() (string, error)
+	// SetToken stores token.
+	SetToken(token string) error
+	// String describes where the token is stored.
+	String() string
+}
+
+// NewCredentialStore returns the store named name: "config" keeps the token
+// under github.token in the config file, "file" in a separate file readable
+// only by the user.
+func NewCredentialStore(name string) (CredentialStore, error) {
+	switch name {
+	case "", CredentialStoreConfig:
+		return configStore{}, nil
+	case CredentialStoreFile:
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return nil, err
+		}
+		return fileStore(filepath.Join(dir, "cli-github-issues", "token")), nil
+	default:
+		return nil, fmt.Errorf("unknown credential store %q, expected %s or %s", name, CredentialStoreConfig, CredentialStoreFile)
+	}
+}
+
+type configStore struct{}
+
+func (configStore) Token() (string, error) {
+	return viper.GetString("github.token"), nil
+}
+
+// SetToken writes token into the config file, creating it if there is none
+// yet. The file is read into a fresh viper instance so that values of flags
+// bound to the global one, such as --owner, are not written with it.
+func (configStore) SetToken(token string) error {
+	path := viper.ConfigFileUsed()
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	v.Set("github.token", token)
+	// a config file created here holds the token, so only the user may read it
+	v.SetConfigPermissions(0o600)
+	return v.WriteConfig()
+}
+
+func (configStore) String() string {
+	return viper.ConfigFileUsed()
+}
+
+type fileStore string
+
+func (s fileStore) Token() (string, error) {
+	b, err := os.ReadFile(string(s))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	return strings.TrimSpace(string(b)), err
+}
+
+func (s fileStore) SetToken(token string) error {
+	if err := os.MkdirAll(filepath.Dir(string(s)), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(string(s), []byte(token+"\n"), 0o600)
+}
+
+func (s fileStore) String() string {
+	return string(s)
+}
@@ -0,0 +1,92 @@
+package export
+
+import (
+	"cli-github-issues/internal/github"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// Checkpoint records the progress of an export, so that an interrupted export
+// can resume after the last issue it wrote. Issues are exported in order of
+// creation.
+type Checkpoint struct {
+	// Repo, Format, Output and Options identify the export; a checkpoint
+	// only resumes the same export.
+	Repo    string `json:"repo"`
+	Format  string `json:"format"`
+	Output  string `json:"output"`
+	Options string `json:"options"`
+
+	// LastNumber and LastCreatedAt identify the last exported issue.
+	LastNumber    int       `json:"last_number"`
+	LastCreatedAt time.Time `json:"last_created_at"`
+
+	// Offset is the size of the output file after the last exported issue.
+	Offset int64 `json:"offset"`
+
+	// Exported counts the exported issues.
+	Exported int `json:"exported"`
+}
+
+// LoadCheckpoint reads the checkpoint at path. It returns nil without error
+// if there is none.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	const op = "export.LoadCheckpoint"
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	cp := new(Checkpoint)
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return cp, nil
+}
+
+// Save writes the checkpoint to path, replacing the previous one atomically.
+func (c *Checkpoint) Save(path string) error {
+	const op = "export.Checkpoint.Save"
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Matches reports whether c was written by the export described by other.
+func (c *Checkpoint) Matches(other *Checkpoint) bool {
+	return c.Repo == other.Repo && c.Format == other.Format && c.Output == other.Output && c.Options == other.Options
+}
+
+// Done reports whether issue was exported before the checkpoint was taken.
+func (c *Checkpoint) Done(issue *github.Issue) bool {
+	if c.Exported == 0 {
+		return false
+	}
+	created := issue.GetCreatedAt()
+	return created.Before(c.LastCreatedAt) || (created.Equal(c.LastCreatedAt) && issue.GetNumber() <= c.LastNumber)
+}
+
+// Advance records issue as exported, with the output at offset.
+func (c *Checkpoint) Advance(issue *github.Issue, offset int64) {
+	c.LastNumber = issue.GetNumber()
+	c.LastCreatedAt = issue.GetCreatedAt()
+	c.Offset = offset
+	c.Exported++
+}
@@ -0,0 +1,244 @@
+package export
+
+import (
+	"cli-github-issues/internal/github"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formats lists the supported export formats.
+var Formats = []string{"jsonl", "csv", "markdown"}
+
+// Record is an exported issue with the related data that was requested.
+type Record struct {
+	Issue     *github.Issue          `json:"issue"`
+	Comments  []*github.IssueComment `json:"comments,omitempty"`
+	Events    []*github.IssueEvent   `json:"events,omitempty"`
+	Reactions []*github.Reaction     `json:"reactions,omitempty"`
+}
+
+// Writer writes records in one of the export formats. Each Write is complete
+// once it returns, so that the output can be checkpointed after it.
+type Writer interface {
+	Write(r *Record) error
+}
+
+// JSONLWriter writes one JSON object per record and line.
+type JSONLWriter struct {
+	enc *json.Encoder
+}
+
+// NewJSONLWriter returns a JSONLWriter writing to w.
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return &JSONLWriter{enc}
+}
+
+func (w *JSONLWriter) Write(r *Record) error {
+	return w.enc.Encode(r)
+}
+
+// columns maps the CSV column names to their values.
+var columns = map[string]func(r *Record) string{
+	"number":       func(r *Record) string { return strconv.Itoa(r.Issue.GetNumber()) },
+	"title":        func(r *Record) string { return r.Issue.GetTitle() },
+	"state":        func(r *Record) string { return r.Issue.GetState() },
+	"state_reason": func(r *Record) string { return r.Issue.GetStateReason() },
+	"author":       func(r *Record) string { return r.Issue.GetUser().GetLogin() },
+	"assignees":    func(r *Record) string { return strings.Join(r.Issue.AssigneeLogins(), ", ") },
+	"labels":       func(r *Record) string { return strings.Join(r.Issue.LabelNames(), ", ") },
+	"milestone":    func(r *Record) string { return r.Issue.GetMilestone().GetTitle() },
+	"comments":     func(r *Record) string { return strconv.Itoa(r.Issue.GetComments()) },
+	"reactions":    func(r *Record) string { return strconv.Itoa(r.Issue.GetReactions().GetTotalCount()) },
+	"locked":       func(r *Record) string { return strconv.FormatBool(r.Issue.GetLocked()) },
+	"created_at":   func(r *Record) string { return formatTime(r.Issue.GetCreatedAt()) },
+	"updated_at":   func(r *Record) string { return formatTime(r.Issue.GetUpdatedAt()) },
+	"closed_at":    func(r *Record) string { return formatTime(r.Issue.GetClosedAt()) },
+	"url":          func(r *Record) string { return r.Issue.GetHTMLURL() },
+	"body":         func(r *Record) string { return r.Issue.GetBody() },
+}
+
+// Columns lists the CSV column names.
+var Columns = []string{
+	"number", "title", "state", "state_reason", "author", "assignees", "labels", "milestone",
+	"comments", "reactions", "locked", "created_at", "updated_at", "closed_at", "url", "body",
+}
+
+// DefaultColumns are the CSV columns written when none are selected.
+var DefaultColumns = []string{
+	"number", "title", "state", "author", "assignees", "labels", "milestone",
+	"created_at", "updated_at", "closed_at", "url",
+}
+
+// CSVWriter writes one row of the selected columns per record.
+type CSVWriter struct {
+	w       *csv.Writer
+	columns []string
+	header  bool
+}
+
+// NewCSVWriter returns a CSVWriter writing the named columns to w, preceded
+// by a header row if header is set.
+func NewCSVWriter(w io.Writer, names []string, header bool) (*CSVWriter, error) {
+	const op = "export.NewCSVWriter"
+
+	for _, name := range names {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%s: unknown column %q, expected one of %v", op, name, Columns)
+		}
+	}
+	return &CSVWriter{w: csv.NewWriter(w), columns: names, header: header}, nil
+}
+
+func (w *CSVWriter) Write(r *Record) error {
+	if w.header {
+		if err := w.w.Write(w.columns); err != nil {
+			return err
+		}
+		w.header = false
+	}
+
+	row := make([]string, len(w.columns))
+	for i, name := range w.columns {
+		row[i] = columns[name](r)
+	}
+	if err := w.w.Write(row); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// MarkdownWriter writes each record to "<number>.md" in a directory, with the
+// issue metadata as YAML front matter followed by the body, comments and
+// events.
+type MarkdownWriter struct {
+	dir string
+}
+
+// NewMarkdownWriter returns a MarkdownWriter writing to dir, which is created
+// if missing.
+func NewMarkdownWriter(dir string) (*MarkdownWriter, error) {
+	const op = "export.NewMarkdownWriter"
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &MarkdownWriter{dir}, nil
+}
+
+func (w *MarkdownWriter) Write(r *Record) error {
+	path := filepath.Join(w.dir, fmt.Sprintf("%d.md", r.Issue.GetNumber()))
+	return os.WriteFile(path, []byte(Markdown(r)), 0o644)
+}
+
+// Markdown renders a record as a Markdown document with front matter.
+func Markdown(r *Record) string {
+	issue := r.Issue
+	var b strings.Builder
+
+	// front matter
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "number: %d\n", issue.GetNumber())
+	fmt.Fprintf(&b, "title: %s\n", strconv.Quote(issue.GetTitle()))
+	fmt.Fprintf(&b, "state: %s\n", issue.GetState())
+	if reason := issue.GetStateReason(); reason != "" {
+		fmt.Fprintf(&b, "state_reason: %s\n", reason)
+	}
+	fmt.Fprintf(&b, "author: %s\n", strconv.Quote(issue.GetUser().GetLogin()))
+	fmt.Fprintf(&b, "assignees: %s\n", yamlList(issue.AssigneeLogins()))
+	fmt.Fprintf(&b, "labels: %s\n", yamlList(issue.LabelNames()))
+	if m := issue.GetMilestone(); m != nil {
+		fmt.Fprintf(&b, "milestone: %s\n", strconv.Quote(m.GetTitle()))
+	}
+	fmt.Fprintf(&b, "created_at: %s\n", formatTime(issue.GetCreatedAt()))
+	fmt.Fprintf(&b, "updated_at: %s\n", formatTime(issue.GetUpdatedAt()))
+	if t := issue.GetClosedAt(); !t.IsZero() {
+		fmt.Fprintf(&b, "closed_at: %s\n", formatTime(t))
+	}
+	fmt.Fprintf(&b, "url: %s\n", strconv.Quote(issue.GetHTMLURL()))
+	if len(r.Reactions) > 0 {
+		b.WriteString("reactions:\n")
+		counts := map[string]int{}
+		for _, reaction := range r.Reactions {
+			counts[reaction.GetContent()]++
+		}
+		contents := make([]string, 0, len(counts))
+		for content := range counts {
+			contents = append(contents, content)
+		}
+		sort.Strings(contents)
+		for _, content := range contents {
+			fmt.Fprintf(&b, "  %s: %d\n", strconv.Quote(content), counts[content])
+		}
+	}
+	b.WriteString("---\n\n")
+
+	// body
+	fmt.Fprintf(&b, "# %s\n\n", issue.GetTitle())
+	if body := strings.TrimSpace(issue.GetBody()); body != "" {
+		b.WriteString(body + "\n")
+	}
+
+	if len(r.Comments) > 0 {
+		b.WriteString("\n## Comments\n")
+		for _, c := range r.Comments {
+			fmt.Fprintf(&b, "\n### %s on %s\n\n", c.GetUser().GetLogin(), formatTime(c.GetCreatedAt()))
+			b.WriteString(strings.TrimSpace(c.GetBody()) + "\n")
+		}
+	}
+
+	if len(r.Events) > 0 {
+		b.WriteString("\n## Events\n\n")
+		for _, e := range r.Events {
+			fmt.Fprintf(&b, "- %s %s %s", formatTime(e.GetCreatedAt()), e.GetActor().GetLogin(), e.GetEvent())
+			if detail := eventDetail(e); detail != "" {
+				b.WriteString(" " + detail)
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// eventDetail returns what an event applied to, if anything.
+func eventDetail(e *github.IssueEvent) string {
+	switch {
+	case e.Label != nil:
+		return e.Label.GetName()
+	case e.Assignee != nil:
+		return e.Assignee.GetLogin()
+	case e.Milestone != nil:
+		return e.Milestone.GetTitle()
+	case e.Rename != nil:
+		return fmt.Sprintf("%q to %q", e.Rename.GetFrom(), e.Rename.GetTo())
+	case e.GetStateReason() != "":
+		return e.GetStateReason()
+	}
+	return ""
+}
+
+func yamlList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// formatTime formats t as RFC 3339 in UTC, or "" if t is zero.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
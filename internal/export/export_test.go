@@ -0,0 +1,162 @@
+package export
+
+import (
+	"bytes"
+	"cli-github-issues/internal/github"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var testTime = time.Date(2026, time.March, 4, 5, 6, 7, 0, time.UTC)
+
+func testRecord() *Record {
+	return &Record{
+		Issue: &github.Issue{
+			Number:    github.Int(12),
+			Title:     github.String(`Crash on "save"`),
+			State:     github.String("open"),
+			User:      &github.User{Login: github.String("octocat")},
+			Labels:    []*github.Label{{Name: github.String("bug")}, {Name: github.String("ui")}},
+			CreatedAt: &testTime,
+			UpdatedAt: &testTime,
+			Body:      github.String("It crashes."),
+			HTMLURL:   github.String("https://github.com/o/r/issues/12"),
+		},
+		Comments: []*github.IssueComment{
+			{User: &github.User{Login: github.String("hubot")}, CreatedAt: &testTime, Body: github.String("Confirmed.")},
+		},
+		Events: []*github.IssueEvent{
+			{Actor: &github.User{Login: github.String("hubot")}, Event: github.String("labeled"), CreatedAt: &testTime, Label: &github.Label{Name: github.String("bug")}},
+		},
+		Reactions: []*github.Reaction{
+			{Content: github.String("+1")}, {Content: github.String("heart")}, {Content: github.String("+1")},
+		},
+	}
+}
+
+func TestJSONLWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewJSONLWriter(&buf)
+	r := &Record{Issue: &github.Issue{Number: github.Int(1), Title: github.String("<a>")}}
+	for i := 0; i < 2; i++ {
+		if err := w.Write(r); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	want := `{"issue":{"number":1,"title":"<a>"}}` + "\n" + `{"issue":{"number":1,"title":"<a>"}}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("JSONLWriter.Write() got = %s, want %s", got, want)
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewCSVWriter(&buf, []string{"number", "title", "labels", "created_at", "closed_at"}, true)
+	if err != nil {
+		t.Fatalf("NewCSVWriter() error = %v", err)
+	}
+	if err := w.Write(testRecord()); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	want := "number,title,labels,created_at,closed_at\n" +
+		`12,"Crash on ""save""","bug, ui",2026-03-04T05:06:07Z,` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("CSVWriter.Write() got = %q, want %q", got, want)
+	}
+
+	if _, err := NewCSVWriter(&buf, []string{"number", "nope"}, true); err == nil {
+		t.Error("NewCSVWriter() with unknown column error = nil, want error")
+	}
+}
+
+func TestMarkdownWriter(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "issues")
+	w, err := NewMarkdownWriter(dir)
+	if err != nil {
+		t.Fatalf("NewMarkdownWriter() error = %v", err)
+	}
+	if err := w.Write(testRecord()); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "12.md"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	want := `---
+number: 12
+title: "Crash on \"save\""
+state: open
+author: "octocat"
+assignees: []
+labels: ["bug", "ui"]
+created_at: 2026-03-04T05:06:07Z
+updated_at: 2026-03-04T05:06:07Z
+url: "https://github.com/o/r/issues/12"
+reactions:
+  "+1": 2
+  "heart": 1
+---
+
+# Crash on "save"
+
+It crashes.
+
+## Comments
+
+### hubot on 2026-03-04T05:06:07Z
+
+Confirmed.
+
+## Events
+
+- 2026-03-04T05:06:07Z hubot labeled bug
+`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("MarkdownWriter.Write() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.checkpoint")
+
+	cp, err := LoadCheckpoint(path)
+	if err != nil || cp != nil {
+		t.Fatalf("LoadCheckpoint() got = %v, %v, want nil, nil", cp, err)
+	}
+
+	cp = &Checkpoint{Repo: "o/r", Format: "jsonl", Output: "out.jsonl"}
+	first := &github.Issue{Number: github.Int(3), CreatedAt: &testTime}
+	later := testTime.Add(time.Hour)
+	second := &github.Issue{Number: github.Int(4), CreatedAt: &later}
+	if cp.Done(first) {
+		t.Error("Done() before any export got = true, want false")
+	}
+	cp.Advance(first, 120)
+	if err := cp.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := LoadCheckpoint(path)
+	if err != nil {
+		t.Fatalf("LoadCheckpoint() error = %v", err)
+	}
+	if !cmp.Equal(loaded, cp) {
+		t.Errorf("LoadCheckpoint() got = %+v, want %+v", loaded, cp)
+	}
+	if !loaded.Matches(&Checkpoint{Repo: "o/r", Format: "jsonl", Output: "out.jsonl"}) {
+		t.Error("Matches() got = false, want true")
+	}
+	if loaded.Matches(&Checkpoint{Repo: "o/r", Format: "csv", Output: "out.jsonl"}) {
+		t.Error("Matches() with other format got = true, want false")
+	}
+	if !loaded.Done(first) || loaded.Done(second) {
+		t.Errorf("Done() got = %v, %v, want true, false", loaded.Done(first), loaded.Done(second))
+	}
+}
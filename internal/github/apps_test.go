@@ -0,0 +1,123 @@
+package github
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParsePrivateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assertNilError(t, err)
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	assertNilError(t, err)
+
+	for name, block := range map[string]*pem.Block{
+		"PKCS1": {Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)},
+		"PKCS8": {Type: "PRIVATE KEY", Bytes: pkcs8},
+	} {
+		got, err := ParsePrivateKey(pem.EncodeToMemory(block))
+		if err != nil || !got.Equal(key) {
+			t.Errorf("ParsePrivateKey(%s) got = %v, want the generated key", name, err)
+		}
+	}
+
+	if _, err := ParsePrivateKey([]byte("not a key")); err == nil {
+		t.Errorf("ParsePrivateKey() expected error for invalid PEM")
+	}
+}
+
+func TestNewClient_AppAuth(t *testing.T) {
+	setupTest()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assertNilError(t, err)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	issued := 0
+	mux.Handle("/app/installations/42/access_tokens", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testAppJWT(t, r, &key.PublicKey, "7")
+
+		// create test response
+		issued++
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": "ghs_%d", "expires_at": %q}`, issued, now.Add(time.Hour).Format(time.RFC3339))
+	}))
+	mux.Handle("/repos/testOwner/testRepo/issues/1", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, testHeaderAuthorization, fmt.Sprintf("Bearer ghs_%d", issued))
+		fmt.Fprintf(w, `{"number": 1}`)
+	}))
+
+	// app client configured to use test server, logging bodies
+	var out bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	appClient, err := NewClient(&http.Client{}, "", WithAppAuth(7, 42, key), WithLogger(logger))
+	assertNilError(t, err)
+	appClient.BaseUrl, _ = url.Parse(server.URL + "/")
+	appClient.app.now = func() time.Time { return now }
+
+	// the installation token is cached
+	for i := 0; i < 2; i++ {
+		_, _, err := appClient.Issues.Get("testOwner", "testRepo", 1)
+		assertNilError(t, err)
+	}
+	if issued != 1 {
+		t.Errorf("installation tokens issued = %v, want %v", issued, 1)
+	}
+
+	// and refreshed shortly before it expires
+	now = now.Add(58 * time.Minute)
+	_, _, err = appClient.Issues.Get("testOwner", "testRepo", 1)
+	assertNilError(t, err)
+	if issued != 2 {
+		t.Errorf("installation tokens issued = %v, want %v", issued, 2)
+	}
+
+	// installation tokens never show up in the log
+	if strings.Contains(out.String(), "ghs_") {
+		t.Errorf("log contains an installation token:\n%s", out.String())
+	}
+}
+
+func testAppJWT(t *testing.T, r *http.Request, pub *rsa.PublicKey, iss string) {
+	t.Helper()
+	jwt, ok := strings.CutPrefix(r.Header.Get(testHeaderAuthorization), "Bearer ")
+	parts := strings.Split(jwt, ".")
+	if !ok || len(parts) != 3 {
+		t.Fatalf("Authorization header is not a JWT: %q", r.Header.Get(testHeaderAuthorization))
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	assertNilError(t, err)
+	sum := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, sum[:], sig); err != nil {
+		t.Errorf("JWT signature: %v", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	assertNilError(t, err)
+	var claims struct {
+		Iss string `json:"iss"`
+		Iat int64  `json:"iat"`
+		Exp int64  `json:"exp"`
+	}
+	assertNilError(t, json.Unmarshal(payload, &claims))
+	if claims.Iss != iss || claims.Exp-claims.Iat > int64((10*time.Minute).Seconds()) {
+		t.Errorf("JWT claims = %+v, want iss %v and a lifetime of at most 10 minutes", claims, iss)
+	}
+}
@@ -0,0 +1,233 @@
+package github
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	headerETag            = "ETag"
+	headerLastModified    = "Last-Modified"
+	headerIfNoneMatch     = "If-None-Match"
+	headerIfModifiedSince = "If-Modified-Since"
+	headerFromCache       = "X-From-Cache"
+
+	headerContentLength = "Content-Length"
+
+	// invalidatedFile holds the time of the last request that changed data.
+	// Entries stored before it are revalidated regardless of their age.
+	invalidatedFile = "invalidated"
+)
+
+// rateHeaders describe the rate limit as of a response, so a copy served
+// without a request must not repeat them.
+var rateHeaders = []string{
+	headerRateLimit, headerRateRemaining, headerRateReset,
+	"X-RateLimit-Used", "X-RateLimit-Resource", headerRetryAfter,
+}
+
+// cacheEntry is a stored response of a GET request.
+type cacheEntry struct {
+	URL      string      `json:"url"`
+	Header   http.Header `json:"header"`
+	Body     []byte      `json:"body"`
+	StoredAt time.Time   `json:"stored_at"`
+}
+
+// WithCache makes the client keep successful GET responses in dir. Within ttl
+// of being stored a response is served without a request; after that it is
+// revalidated with If-None-Match/If-Modified-Since, and a 304 Not Modified,
+// which does not count against the rate limit, is answered from the cache.
+func WithCache(dir string, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cache = &httpCache{dir: dir, ttl: ttl}
+	}
+}
+
+// DefaultCacheDir returns the directory for the response cache under the
+// user's cache directory.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "cli-github-issues", "http"), nil
+}
+
+// ClearCache removes every stored response from dir.
+func ClearCache(dir string) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("github.ClearCache: %w", err)
+	}
+	return nil
+}
+
+type httpCache struct {
+	dir string
+	ttl time.Duration
+}
+
+// transport answers GET requests from the cache where possible and stores
+// their responses. Other successful requests mark all entries stale.
+func (hc *httpCache) transport(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(
+		func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+				resp, err := next.RoundTrip(req)
+				if err == nil && resp.StatusCode < http.StatusBadRequest {
+					hc.invalidate()
+				}
+				return resp, err
+			}
+
+			key := hc.key(req)
+			entry := hc.load(key)
+			if entry != nil && hc.fresh(entry) {
+				resp := entry.response(req)
+				for _, name := range rateHeaders {
+					resp.Header.Del(name)
+				}
+				return resp, nil
+			}
+
+			// revalidate stored response
+			if entry != nil {
+				req = req.Clone(req.Context())
+				if etag := entry.Header.Get(headerETag); etag != "" {
+					req.Header.Set(headerIfNoneMatch, etag)
+				}
+				if modified := entry.Header.Get(headerLastModified); modified != "" {
+					req.Header.Set(headerIfModifiedSince, modified)
+				}
+			}
+			resp, err := next.RoundTrip(req)
+			if err != nil {
+				return nil, err
+			}
+			if entry != nil && resp.StatusCode == http.StatusNotModified {
+				resp.Body.Close()
+				// the 304 carries the current rate limit, date and so on
+				for name, values := range resp.Header {
+					if name != headerContentLength {
+						entry.Header[name] = values
+					}
+				}
+				entry.StoredAt = time.Now()
+				hc.store(key, entry)
+				return entry.response(req), nil
+			}
+			if resp.StatusCode != http.StatusOK {
+				return resp, nil
+			}
+
+			// store new response
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			if resp.Header.Get(headerETag) != "" || resp.Header.Get(headerLastModified) != "" || hc.ttl > 0 {
+				hc.store(key, &cacheEntry{req.URL.String(), resp.Header.Clone(), body, time.Now()})
+			}
+			return resp, nil
+		},
+	)
+}
+
+// key identifies a response by URL, media type and credentials, so that
+// users never see each other's responses.
+func (hc *httpCache) key(req *http.Request) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		req.URL.String(),
+		req.Header.Get(headerAccept),
+		req.Header.Get(headerAuthorization),
+	}, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+func (hc *httpCache) fresh(entry *cacheEntry) bool {
+	if time.Since(entry.StoredAt) >= hc.ttl {
+		return false
+	}
+	data, err := os.ReadFile(filepath.Join(hc.dir, invalidatedFile))
+	if err != nil {
+		return true
+	}
+	invalidated, err := time.Parse(time.RFC3339Nano, string(data))
+	return err == nil && entry.StoredAt.After(invalidated)
+}
+
+func (hc *httpCache) load(key string) *cacheEntry {
+	data, err := os.ReadFile(filepath.Join(hc.dir, key+".json"))
+	if err != nil {
+		return nil
+	}
+	entry := new(cacheEntry)
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil
+	}
+	return entry
+}
+
+// store writes entry atomically; failures only cost a future cache miss.
+func (hc *httpCache) store(key string, entry *cacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(hc.dir, 0o700); err != nil {
+		return
+	}
+	f, err := os.CreateTemp(hc.dir, key+".*.tmp")
+	if err != nil {
+		return
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return
+	}
+	if err := f.Close(); err != nil {
+		return
+	}
+	os.Rename(f.Name(), filepath.Join(hc.dir, key+".json"))
+}
+
+func (hc *httpCache) invalidate() {
+	if err := os.MkdirAll(hc.dir, 0o700); err != nil {
+		return
+	}
+	os.WriteFile(filepath.Join(hc.dir, invalidatedFile), []byte(time.Now().Format(time.RFC3339Nano)), 0o600)
+}
+
+// response builds a 200 OK response for req from the stored entry.
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	header := e.Header.Clone()
+	header.Set(headerFromCache, "1")
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// IsFromCache reports whether resp was served from the response cache.
+func IsFromCache(resp *http.Response) bool {
+	return resp != nil && resp.Header.Get(headerFromCache) != ""
+}
@@ -0,0 +1,90 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// setupCacheTest returns a client using the test server with a response cache.
+func setupCacheTest(t *testing.T, ttl time.Duration) *Client {
+	t.Helper()
+	setupTest()
+
+	c, err := NewClient(&http.Client{}, testToken, WithCache(t.TempDir(), ttl))
+	assertNilError(t, err)
+	c.BaseUrl, _ = url.Parse(server.URL + "/")
+	return c
+}
+
+func TestCache_Revalidate(t *testing.T) {
+	cacheClient := setupCacheTest(t, 0)
+
+	sent := 0
+	mux.Handle("/repos/testOwner/testRepo/issues/1", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent++
+		w.Header().Set(headerRateRemaining, fmt.Sprint(5000-sent))
+		if r.Header.Get(headerIfNoneMatch) == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set(headerETag, `"v1"`)
+		fmt.Fprintf(w, `{"number": 1, "title": "Issue"}`)
+	}))
+
+	// first request fills the cache
+	issue, resp, err := cacheClient.Issues.Get("testOwner", "testRepo", 1)
+	assertNilError(t, err)
+	if IsFromCache(resp) || issue.GetTitle() != "Issue" {
+		t.Errorf("Issues.Get() got = %v from cache %v, want fresh response", issue.GetTitle(), IsFromCache(resp))
+	}
+
+	// second request is revalidated and answered from the cache
+	issue, resp, err = cacheClient.Issues.Get("testOwner", "testRepo", 1)
+	assertNilError(t, err)
+	if !IsFromCache(resp) || resp.StatusCode != http.StatusOK || issue.GetTitle() != "Issue" {
+		t.Errorf("Issues.Get() got = %v %v from cache %v, want cached response", resp.StatusCode, issue.GetTitle(), IsFromCache(resp))
+	}
+	if sent != 2 {
+		t.Errorf("requests sent = %v, want %v", sent, 2)
+	}
+
+	// with the rate limit of the 304 rather than the stored one
+	if rate, _ := ParseRate(resp); rate.Remaining != 4998 {
+		t.Errorf("ParseRate() of cached response got remaining = %v, want %v", rate.Remaining, 4998)
+	}
+}
+
+func TestCache_TTL(t *testing.T) {
+	cacheClient := setupCacheTest(t, time.Hour)
+
+	sent := 0
+	mux.Handle("/repos/testOwner/testRepo/issues/1", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent++
+		w.Header().Set(headerRateRemaining, "4999")
+		fmt.Fprintf(w, `{"number": 1}`)
+	}))
+
+	// fresh entries are served without a request, nor its rate limit
+	for i := 0; i < 3; i++ {
+		_, resp, err := cacheClient.Issues.Get("testOwner", "testRepo", 1)
+		assertNilError(t, err)
+		if _, ok := ParseRate(resp); ok && IsFromCache(resp) {
+			t.Errorf("ParseRate() of cached response got ok = %v, want %v", ok, false)
+		}
+	}
+	if sent != 1 {
+		t.Errorf("requests sent = %v, want %v", sent, 1)
+	}
+
+	// changes make entries stale
+	_, _, err := cacheClient.Issues.Update("testOwner", "testRepo", 1, &IssueRequest{Title: String("New")})
+	assertNilError(t, err)
+	_, _, err = cacheClient.Issues.Get("testOwner", "testRepo", 1)
+	assertNilError(t, err)
+	if sent != 3 {
+		t.Errorf("requests sent = %v, want %v", sent, 3)
+	}
+}
@@ -0,0 +1,74 @@
+package github
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const headerDryRun = "X-Dry-Run"
+
+// dryRunTransport prints every request that may change data to w and answers
+// it with a synthetic empty response instead of sending it. GET and HEAD
+// requests are passed on to next.
+func dryRunTransport(next http.RoundTripper, w io.Writer) http.RoundTripper {
+	return roundTripperFunc(
+		func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodGet || req.Method == http.MethodHead {
+				return next.RoundTrip(req)
+			}
+
+			// print the request with its JSON body
+			var body []byte
+			if req.Body != nil {
+				var err error
+				if body, err = io.ReadAll(req.Body); err != nil {
+					return nil, err
+				}
+				req.Body.Close()
+			}
+			fmt.Fprintf(w, "%s %s\n", req.Method, req.URL)
+			if len(body) > 0 {
+				fmt.Fprintf(w, "%s\n", bytes.TrimRight(body, "\n"))
+			}
+
+			// answer with the status GitHub uses for the method
+			status, respBody := dryRunStatus(req.Method), ""
+			if strings.HasSuffix(req.URL.Path, "/graphql") {
+				status, respBody = http.StatusOK, `{"data":null}`
+			}
+			header := http.Header{}
+			header.Set("Content-Type", "application/json")
+			header.Set(headerDryRun, "true")
+			return &http.Response{
+				Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+				StatusCode:    status,
+				Proto:         "HTTP/1.1",
+				ProtoMajor:    1,
+				ProtoMinor:    1,
+				Header:        header,
+				Body:          io.NopCloser(strings.NewReader(respBody)),
+				ContentLength: int64(len(respBody)),
+				Request:       req,
+			}, nil
+		},
+	)
+}
+
+func dryRunStatus(method string) int {
+	switch method {
+	case http.MethodPost:
+		return http.StatusCreated
+	case http.MethodPut, http.MethodDelete:
+		return http.StatusNoContent
+	default:
+		return http.StatusOK
+	}
+}
+
+// IsDryRun reports whether resp is a synthetic response of a dry-run client.
+func IsDryRun(resp *http.Response) bool {
+	return resp != nil && resp.Header.Get(headerDryRun) != ""
+}
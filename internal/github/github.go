@@ -2,9 +2,11 @@ package github
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 )
@@ -20,25 +22,75 @@ const (
 )
 
 type Client struct {
-	client  *http.Client
-	BaseUrl *url.URL
-	common  service
-	Issues  *IssuesService
+	client       *http.Client
+	BaseUrl      *url.URL
+	dryRun       io.Writer
+	logger       *slog.Logger
+	cache        *httpCache
+	app          *appAuth
+	common       service
+	Issues       *IssuesService
+	Reactions    *ReactionsService
+	Repositories *RepositoriesService
+	Search       *SearchService
+	Users        *UsersService
 }
 
 type service struct {
 	client *Client
 }
 
-func NewClient(client *http.Client, token string) (*Client, error) {
+// ClientOption configures optional behaviour of a Client.
+type ClientOption func(*Client)
+
+// WithDryRun makes the client print non-GET requests to w instead of sending
+// them. GET requests are still sent so that previews stay realistic.
+func WithDryRun(w io.Writer) ClientOption {
+	return func(c *Client) {
+		c.dryRun = w
+	}
+}
+
+// WithLogger makes the client log every request and response to logger.
+// Headers and bodies are logged at debug level, with credentials redacted.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+func NewClient(client *http.Client, token string, opts ...ClientOption) (*Client, error) {
 	c := &Client{client: client}
+	for _, opt := range opts {
+		opt(c)
+	}
 	transport := c.client.Transport
 	if transport == nil {
 		transport = http.DefaultTransport
 	}
+	// installation tokens are requested past the logging, cache and dry-run
+	// transports, as the logged response body would hold the token
+	tokenTransport := transport
+	if c.logger != nil {
+		transport = loggingTransport(transport, c.logger)
+	}
+	if c.cache != nil {
+		transport = c.cache.transport(transport)
+	}
+	if c.dryRun != nil {
+		transport = dryRunTransport(transport, c.dryRun)
+	}
 	c.client.Transport = roundTripperFunc(
 		func(req *http.Request) (*http.Response, error) {
 			req = req.Clone(req.Context())
+			if c.app != nil {
+				t, err := c.app.installationToken(req.Context(), c, tokenTransport)
+				if err != nil {
+					return nil, err
+				}
+				req.Header.Set(headerAuthorization, fmt.Sprintf("Bearer %s", t))
+				return transport.RoundTrip(req)
+			}
 			req.Header.Set(headerAuthorization, fmt.Sprintf("Bearer %s", token))
 			return transport.RoundTrip(req)
 		},
@@ -62,10 +114,18 @@ func (c *Client) initialize() error {
 	}
 
 	c.Issues = (*IssuesService)(&c.common)
+	c.Reactions = (*ReactionsService)(&c.common)
+	c.Repositories = (*RepositoriesService)(&c.common)
+	c.Search = (*SearchService)(&c.common)
+	c.Users = (*UsersService)(&c.common)
 	return nil
 }
 
 func (c *Client) NewRequest(method string, urlStr string, body any) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, urlStr, body)
+}
+
+func (c *Client) NewRequestWithContext(ctx context.Context, method string, urlStr string, body any) (*http.Request, error) {
 	fullUrl, err := c.BaseUrl.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -76,7 +136,7 @@ func (c *Client) NewRequest(method string, urlStr string, body any) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest(method, fullUrl.String(), bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, fullUrl.String(), bodyReader)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +160,9 @@ func makeRequestBodyIfExist(body any) (io.ReadWriter, error) {
 	return buf, nil
 }
 
+// Do sends req and decodes the JSON response body into res. If res is an
+// io.Writer, the body is copied to it as is instead, e.g. for media types
+// other than JSON.
 func (c *Client) Do(req *http.Request, res any) (*http.Response, error) {
 	resp, err := c.client.Do(req)
 	if err != nil {
@@ -108,7 +171,17 @@ func (c *Client) Do(req *http.Request, res any) (*http.Response, error) {
 	defer resp.Body.Close()
 
 	// Notice: ignore status code
-	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+	if res == nil {
+		return resp, nil
+	}
+	if w, ok := res.(io.Writer); ok {
+		if _, err := io.Copy(w, resp.Body); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+	// empty bodies, e.g. of 204 No Content, leave res untouched
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil && err != io.EOF {
 		return nil, err
 	}
 	return resp, nil
@@ -123,3 +196,7 @@ func (fn roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
 func String(v string) *string { return &v }
 
 func Int(v int) *int { return &v }
+
+func Int64(v int64) *int64 { return &v }
+
+func Bool(v bool) *bool { return &v }
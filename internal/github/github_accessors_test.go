@@ -0,0 +1,79 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestIssue_NilAccessors(t *testing.T) {
+	var issue *Issue
+
+	if got := issue.GetTitle(); got != "" {
+		t.Errorf("Issue.GetTitle() got = %q, want %q", got, "")
+	}
+	if got := issue.GetUser().GetLogin(); got != "" {
+		t.Errorf("Issue.GetUser().GetLogin() got = %q, want %q", got, "")
+	}
+	if got := issue.GetMilestone().GetDueOn(); !got.IsZero() {
+		t.Errorf("Issue.GetMilestone().GetDueOn() got = %v, want zero time", got)
+	}
+	if issue.IsPullRequest() {
+		t.Errorf("Issue.IsPullRequest() got = %v, want %v", true, false)
+	}
+	if got := issue.LabelNames(); got != nil {
+		t.Errorf("Issue.LabelNames() got = %v, want nil", got)
+	}
+	if got := issue.AssigneeLogins(); got != nil {
+		t.Errorf("Issue.AssigneeLogins() got = %v, want nil", got)
+	}
+}
+
+func TestIssue_Decode(t *testing.T) {
+	data := `{
+		"number": 7,
+		"title": "Crash",
+		"body": null,
+		"user": {"login": "octocat"},
+		"labels": [{"name": "bug", "color": "d73a4a"}],
+		"assignees": [{"login": "hubot"}],
+		"milestone": {"number": 1, "title": "v1.0"},
+		"comments": 3,
+		"locked": true,
+		"active_lock_reason": "too heated",
+		"updated_at": "2026-01-02T03:04:05Z",
+		"reactions": {"total_count": 5, "+1": 4, "-1": 1},
+		"pull_request": {"html_url": "https://github.com/o/r/pull/7"}
+	}`
+
+	issue := new(Issue)
+	assertNilError(t, json.Unmarshal([]byte(data), issue))
+
+	if got := issue.GetBody(); got != "" {
+		t.Errorf("Issue.GetBody() got = %q, want %q", got, "")
+	}
+	if got := issue.Labels[0].GetName(); got != "bug" {
+		t.Errorf("Issue.Labels[0].GetName() got = %q, want %q", got, "bug")
+	}
+	if got := issue.Assignees[0].GetLogin(); got != "hubot" {
+		t.Errorf("Issue.Assignees[0].GetLogin() got = %q, want %q", got, "hubot")
+	}
+	if got := issue.GetMilestone().GetTitle(); got != "v1.0" {
+		t.Errorf("Issue.GetMilestone().GetTitle() got = %q, want %q", got, "v1.0")
+	}
+	if got := issue.GetComments(); got != 3 {
+		t.Errorf("Issue.GetComments() got = %d, want %d", got, 3)
+	}
+	if !issue.GetLocked() || issue.GetActiveLockReason() != "too heated" {
+		t.Errorf("Issue lock got = %v %q, want %v %q", issue.GetLocked(), issue.GetActiveLockReason(), true, "too heated")
+	}
+	if got, want := issue.GetUpdatedAt(), time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Issue.GetUpdatedAt() got = %v, want %v", got, want)
+	}
+	if got := issue.GetReactions().GetPlusOne(); got != 4 {
+		t.Errorf("Issue.GetReactions().GetPlusOne() got = %d, want %d", got, 4)
+	}
+	if !issue.IsPullRequest() {
+		t.Errorf("Issue.IsPullRequest() got = %v, want %v", false, true)
+	}
+}
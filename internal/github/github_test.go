@@ -1,6 +1,13 @@
 package github
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
 	"testing"
 )
 
@@ -101,6 +108,115 @@ func TestNewClient(t *testing.T) {
 	// TODO
 }
 
+func TestNewClient_DryRun(t *testing.T) {
+	setupTest()
+
+	sent := 0
+	mux.Handle("/repos/testOwner/testRepo/issues/1", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent++
+		fmt.Fprintf(w, `{"number": 1}`)
+	}))
+
+	// dry-run client configured to use test server
+	out := &bytes.Buffer{}
+	dryClient, err := NewClient(&http.Client{}, testToken, WithDryRun(out))
+	assertNilError(t, err)
+	dryClient.BaseUrl, _ = url.Parse(server.URL + "/")
+
+	// GET requests are sent
+	if _, _, err := dryClient.Issues.Get("testOwner", "testRepo", 1); err != nil || sent != 1 {
+		t.Errorf("Issues.Get() sent = %v, want %v, error = %v", sent, 1, err)
+	}
+
+	// other requests are printed and answered locally
+	_, resp, err := dryClient.Issues.Update("testOwner", "testRepo", 1, &IssueRequest{State: String("closed")})
+	assertNilError(t, err)
+	if sent != 1 {
+		t.Errorf("Issues.Update() sent = %v, want %v", sent, 1)
+	}
+	if !IsDryRun(resp) || resp.StatusCode != http.StatusOK {
+		t.Errorf("Issues.Update() got = %v, want a dry-run %v", resp.StatusCode, http.StatusOK)
+	}
+	want := "PATCH " + server.URL + "/repos/testOwner/testRepo/issues/1\n" + `{"state":"closed"}` + "\n"
+	if got := out.String(); got != want {
+		t.Errorf("dry-run output got = %q, want %q", got, want)
+	}
+
+	// 204 responses leave the result untouched
+	resp, err = dryClient.Issues.Lock(context.Background(), "testOwner", "testRepo", 1, "")
+	assertNilError(t, err)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Issues.Lock() got = %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
 func TestDo(t *testing.T) {
 	// TODO
 }
+
+func TestDo_Writer(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/readme", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "# testRepo\n")
+	}))
+
+	request, err := client.NewRequest(http.MethodGet, "repos/testOwner/testRepo/readme", nil)
+	assertNilError(t, err)
+	request.Header.Set(headerAccept, "application/vnd.github.raw")
+
+	// a non-JSON body is copied as is
+	var body bytes.Buffer
+	_, err = client.Do(request, &body)
+	assertNilError(t, err)
+	if got, want := body.String(), "# testRepo\n"; got != want {
+		t.Errorf("Client.Do() got = %q, want %q", got, want)
+	}
+}
+
+func TestNewClient_Logger(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		fmt.Fprintf(w, `{"number": 1}`)
+	}))
+
+	// logging client configured to use test server
+	out := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	logClient, err := NewClient(&http.Client{}, testToken, WithLogger(logger))
+	assertNilError(t, err)
+	logClient.BaseUrl, _ = url.Parse(server.URL + "/")
+
+	issue, _, err := logClient.Issues.Get("testOwner", "testRepo", 1)
+	assertNilError(t, err)
+	if issue.GetNumber() != 1 {
+		t.Errorf("Issues.Get() got = %v, want %v", issue.GetNumber(), 1)
+	}
+
+	// check request and response records
+	dec := json.NewDecoder(out)
+	var request, response struct {
+		Msg       string              `json:"msg"`
+		Status    int                 `json:"status"`
+		Headers   map[string][]string `json:"headers"`
+		Body      string              `json:"body"`
+		RateLimit struct {
+			Remaining int `json:"remaining"`
+		} `json:"rate_limit"`
+	}
+	assertNilError(t, dec.Decode(&request))
+	assertNilError(t, dec.Decode(&response))
+
+	if got := request.Headers[testHeaderAuthorization]; len(got) != 1 || got[0] != "REDACTED" {
+		t.Errorf("request Authorization got = %v, want %v", got, "REDACTED")
+	}
+	if response.Msg != "http response" || response.Status != http.StatusOK || response.RateLimit.Remaining != 4999 {
+		t.Errorf("response record got = %+v, want status %v and 4999 remaining", response, http.StatusOK)
+	}
+	if response.Body != `{"number": 1}` {
+		t.Errorf("response body got = %q, want %q", response.Body, `{"number": 1}`)
+	}
+}
@@ -0,0 +1,188 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors,omitempty"`
+}
+
+// GraphQLResponse wraps the HTTP response of a GraphQL query with the rate
+// limit information GitHub reports for it.
+type GraphQLResponse struct {
+	*http.Response
+
+	// Rate is the GraphQL rate limit as reported by the response headers.
+	Rate Rate
+
+	// Cost is set when the query selected the rateLimit field, e.g.
+	// "rateLimit { cost limit remaining resetAt }".
+	Cost *GraphQLRateLimit
+}
+
+// GraphQLRateLimit is the rateLimit object of the GraphQL API.
+//
+// GITHUB-API docs: https://docs.github.com/en/graphql/overview/rate-limits-and-node-limits-for-the-graphql-api
+type GraphQLRateLimit struct {
+	Cost      int       `json:"cost"`
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Used      int       `json:"used"`
+	NodeCount int       `json:"nodeCount"`
+	ResetAt   time.Time `json:"resetAt"`
+}
+
+// GraphQLError is an error reported in the "errors" list of a GraphQL response.
+type GraphQLError struct {
+	Type       string            `json:"type,omitempty"`
+	Message    string            `json:"message"`
+	Path       []any             `json:"path,omitempty"`
+	Locations  []GraphQLLocation `json:"locations,omitempty"`
+	Extensions map[string]any    `json:"extensions,omitempty"`
+}
+
+// GraphQLLocation points at the part of the query an error refers to.
+type GraphQLLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+func (e GraphQLError) Error() string {
+	var b strings.Builder
+	if e.Type != "" {
+		b.WriteString(e.Type + ": ")
+	}
+	b.WriteString(e.Message)
+	if len(e.Path) > 0 {
+		path := make([]string, len(e.Path))
+		for i, p := range e.Path {
+			path[i] = fmt.Sprint(p)
+		}
+		fmt.Fprintf(&b, " (path %s)", strings.Join(path, "."))
+	}
+	return b.String()
+}
+
+// GraphQLErrors is the error returned by Client.GraphQL when the response
+// lists errors. Data returned alongside the errors is still decoded.
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return "graphql: " + strings.Join(messages, "; ")
+}
+
+// GraphQL executes a GraphQL query or mutation and decodes the "data" of the
+// response into res, which may be nil or a *json.RawMessage.
+//
+// GITHUB-API docs: https://docs.github.com/en/graphql/guides/forming-calls-with-graphql
+func (c *Client) GraphQL(ctx context.Context, query string, variables map[string]any, res any) (*GraphQLResponse, error) {
+	const op = "github.graphql"
+
+	// prepare graphql request
+	request, err := c.NewRequestWithContext(ctx, http.MethodPost, "graphql", &graphQLRequest{query, variables})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do graphql request
+	gqlResp := new(graphQLResponse)
+	resp, err := c.Do(request, gqlResp)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	result := &GraphQLResponse{Response: resp}
+	result.Rate, _ = ParseRate(resp)
+
+	// decode data, even when partial
+	if len(gqlResp.Data) > 0 {
+		var cost struct {
+			RateLimit *GraphQLRateLimit `json:"rateLimit"`
+		}
+		if json.Unmarshal(gqlResp.Data, &cost) == nil {
+			result.Cost = cost.RateLimit
+		}
+		if res != nil {
+			if err := json.Unmarshal(gqlResp.Data, res); err != nil {
+				return result, fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	if len(gqlResp.Errors) > 0 {
+		return result, gqlResp.Errors
+	}
+	if resp.StatusCode != http.StatusOK {
+		return result, fmt.Errorf("%s: invalid status code: %d", op, resp.StatusCode)
+	}
+	return result, nil
+}
+
+// PageInfo is the pageInfo object of a GraphQL connection.
+type PageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// GraphQLPaginate runs a query over every page of a connection and calls fn
+// with the data of each page. The query must declare an "$endCursor: String"
+// variable, pass it as the "after" argument of the connection and select
+// "pageInfo { hasNextPage endCursor }"; the first pageInfo found in the data
+// drives the pagination.
+func (c *Client) GraphQLPaginate(ctx context.Context, query string, variables map[string]any, fn func(data json.RawMessage) error) error {
+	vars := make(map[string]any, len(variables)+1)
+	for k, v := range variables {
+		vars[k] = v
+	}
+
+	for {
+		var data json.RawMessage
+		if _, err := c.GraphQL(ctx, query, vars, &data); err != nil {
+			return err
+		}
+		if err := fn(data); err != nil {
+			return err
+		}
+
+		page, ok := findPageInfo(data)
+		if !ok || !page.HasNextPage || page.EndCursor == "" {
+			return nil
+		}
+		vars["endCursor"] = page.EndCursor
+	}
+}
+
+// findPageInfo returns the first pageInfo object in data, depth first.
+func findPageInfo(data json.RawMessage) (PageInfo, bool) {
+	var obj map[string]json.RawMessage
+	if json.Unmarshal(data, &obj) != nil {
+		return PageInfo{}, false
+	}
+	if raw, ok := obj["pageInfo"]; ok {
+		var page PageInfo
+		if json.Unmarshal(raw, &page) == nil {
+			return page, true
+		}
+	}
+	for _, v := range obj {
+		if page, ok := findPageInfo(v); ok {
+			return page, true
+		}
+	}
+	return PageInfo{}, false
+}
@@ -0,0 +1,111 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_GraphQL(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/graphql", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		if v.Variables["owner"] != "testOwner" {
+			t.Errorf("GraphQL() variables = %v, want owner %v", v.Variables, "testOwner")
+		}
+
+		// create test response
+		w.Header().Set("X-RateLimit-Remaining", "4990")
+		fmt.Fprintf(w, `{"data": {"viewer": {"login": "octocat"}, "rateLimit": {"cost": 1, "remaining": 4990}}}`)
+	}))
+
+	var res struct {
+		Viewer struct {
+			Login string `json:"login"`
+		} `json:"viewer"`
+	}
+	resp, err := client.GraphQL(context.Background(), "query { viewer { login } }", map[string]any{"owner": "testOwner"}, &res)
+	assertNilError(t, err)
+
+	if res.Viewer.Login != "octocat" {
+		t.Errorf("GraphQL() got = %v, want %v", res.Viewer.Login, "octocat")
+	}
+	if resp.Rate.Remaining != 4990 {
+		t.Errorf("GraphQL() rate remaining = %v, want %v", resp.Rate.Remaining, 4990)
+	}
+	if resp.Cost == nil || resp.Cost.Cost != 1 {
+		t.Errorf("GraphQL() cost = %v, want %v", resp.Cost, 1)
+	}
+}
+
+func TestClient_GraphQL_Errors(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/graphql", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// create test response
+		fmt.Fprintf(w, `{"data": {"repository": null}, "errors": [{"type": "NOT_FOUND", "path": ["repository"], "locations": [{"line": 1, "column": 9}], "message": "Could not resolve to a Repository"}]}`)
+	}))
+
+	_, err := client.GraphQL(context.Background(), `query { repository(owner: "o", name: "r") { id } }`, nil, nil)
+
+	var gqlErrs GraphQLErrors
+	if !errors.As(err, &gqlErrs) || len(gqlErrs) != 1 {
+		t.Fatalf("GraphQL() error = %v, want GraphQLErrors", err)
+	}
+	if gqlErrs[0].Type != "NOT_FOUND" || gqlErrs[0].Locations[0].Line != 1 {
+		t.Errorf("GraphQL() error = %+v, want NOT_FOUND at line 1", gqlErrs[0])
+	}
+	if want := "graphql: NOT_FOUND: Could not resolve to a Repository (path repository)"; err.Error() != want {
+		t.Errorf("GraphQL() error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestClient_GraphQLPaginate(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/graphql", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		// create test response
+		if v.Variables["endCursor"] == nil {
+			fmt.Fprintf(w, `{"data": {"repository": {"issues": {"nodes": [{"number": 1}], "pageInfo": {"hasNextPage": true, "endCursor": "c1"}}}}}`)
+		} else {
+			fmt.Fprintf(w, `{"data": {"repository": {"issues": {"nodes": [{"number": 2}], "pageInfo": {"hasNextPage": false, "endCursor": "c2"}}}}}`)
+		}
+	}))
+
+	var numbers []int
+	err := client.GraphQLPaginate(context.Background(), "query($endCursor: String) { ... }", nil, func(data json.RawMessage) error {
+		var page struct {
+			Repository struct {
+				Issues struct {
+					Nodes []struct {
+						Number int `json:"number"`
+					} `json:"nodes"`
+				} `json:"issues"`
+			} `json:"repository"`
+		}
+		if err := json.Unmarshal(data, &page); err != nil {
+			return err
+		}
+		for _, n := range page.Repository.Issues.Nodes {
+			numbers = append(numbers, n.Number)
+		}
+		return nil
+	})
+	assertNilError(t, err)
+
+	if len(numbers) != 2 || numbers[0] != 1 || numbers[1] != 2 {
+		t.Errorf("GraphQLPaginate() got = %v, want %v", numbers, []int{1, 2})
+	}
+}
@@ -1,36 +1,154 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
 type IssuesService service
 
 type Issue struct {
-	Number    *int       `json:"number,omitempty"`
-	HTMLURL   *string    `json:"html_url,omitempty"`
-	Title     *string    `json:"title,omitempty"`
-	State     *string    `json:"state,omitempty"`
-	User      *User      `json:"user,omitempty"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	Body      *string    `json:"body,omitempty"`
+	ID                *int64            `json:"id,omitempty"`
+	NodeID            *string           `json:"node_id,omitempty"`
+	Number            *int              `json:"number,omitempty"`
+	URL               *string           `json:"url,omitempty"`
+	HTMLURL           *string           `json:"html_url,omitempty"`
+	RepositoryURL     *string           `json:"repository_url,omitempty"`
+	CommentsURL       *string           `json:"comments_url,omitempty"`
+	EventsURL         *string           `json:"events_url,omitempty"`
+	LabelsURL         *string           `json:"labels_url,omitempty"`
+	Title             *string           `json:"title,omitempty"`
+	State             *string           `json:"state,omitempty"`
+	User              *User             `json:"user,omitempty"`
+	CreatedAt         *time.Time        `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time        `json:"updated_at,omitempty"`
+	Body              *string           `json:"body,omitempty"`
+	StateReason       *string           `json:"state_reason,omitempty"`
+	ClosedAt          *time.Time        `json:"closed_at,omitempty"`
+	ClosedBy          *User             `json:"closed_by,omitempty"`
+	Labels            []*Label          `json:"labels,omitempty"`
+	Assignee          *User             `json:"assignee,omitempty"`
+	Assignees         []*User           `json:"assignees,omitempty"`
+	Milestone         *Milestone        `json:"milestone,omitempty"`
+	Comments          *int              `json:"comments,omitempty"`
+	Locked            *bool             `json:"locked,omitempty"`
+	ActiveLockReason  *string           `json:"active_lock_reason,omitempty"`
+	AuthorAssociation *string           `json:"author_association,omitempty"`
+	Reactions         *Reactions        `json:"reactions,omitempty"`
+	PullRequestLinks  *PullRequestLinks `json:"pull_request,omitempty"`
+	SubIssuesSummary  *SubIssuesSummary `json:"sub_issues_summary,omitempty"`
+}
+
+// IsPullRequest reports whether the issue is actually a pull request.
+func (i *Issue) IsPullRequest() bool {
+	return i.GetPullRequestLinks() != nil
+}
+
+// RepositoryFullName returns the "owner/repo" name of the repository the
+// issue belongs to, derived from its repository URL.
+func (i *Issue) RepositoryFullName() string {
+	_, name, _ := strings.Cut(i.GetRepositoryURL(), "/repos/")
+	return name
+}
+
+// LabelNames returns the names of the labels of the issue.
+func (i *Issue) LabelNames() []string {
+	if i == nil {
+		return nil
+	}
+	names := make([]string, 0, len(i.Labels))
+	for _, l := range i.Labels {
+		names = append(names, l.GetName())
+	}
+	return names
+}
+
+// AssigneeLogins returns the logins of the assignees of the issue.
+func (i *Issue) AssigneeLogins() []string {
+	if i == nil {
+		return nil
+	}
+	logins := make([]string, 0, len(i.Assignees))
+	for _, u := range i.Assignees {
+		logins = append(logins, u.GetLogin())
+	}
+	return logins
 }
 
 type User struct {
-	Login   *string `json:"login,omitempty"`
-	HTMLURL *string `json:"html_url,omitempty"`
+	ID        *int64  `json:"id,omitempty"`
+	NodeID    *string `json:"node_id,omitempty"`
+	Login     *string `json:"login,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	Email     *string `json:"email,omitempty"`
+	Type      *string `json:"type,omitempty"`
+	SiteAdmin *bool   `json:"site_admin,omitempty"`
+	AvatarURL *string `json:"avatar_url,omitempty"`
+	HTMLURL   *string `json:"html_url,omitempty"`
+}
+
+type Label struct {
+	ID          *int64  `json:"id,omitempty"`
+	NodeID      *string `json:"node_id,omitempty"`
+	URL         *string `json:"url,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	Default     *bool   `json:"default,omitempty"`
+}
+
+type Milestone struct {
+	ID           *int64     `json:"id,omitempty"`
+	NodeID       *string    `json:"node_id,omitempty"`
+	Number       *int       `json:"number,omitempty"`
+	URL          *string    `json:"url,omitempty"`
+	HTMLURL      *string    `json:"html_url,omitempty"`
+	State        *string    `json:"state,omitempty"`
+	Title        *string    `json:"title,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	Creator      *User      `json:"creator,omitempty"`
+	OpenIssues   *int       `json:"open_issues,omitempty"`
+	ClosedIssues *int       `json:"closed_issues,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+	ClosedAt     *time.Time `json:"closed_at,omitempty"`
+	DueOn        *time.Time `json:"due_on,omitempty"`
+}
+
+type Reactions struct {
+	TotalCount *int    `json:"total_count,omitempty"`
+	PlusOne    *int    `json:"+1,omitempty"`
+	MinusOne   *int    `json:"-1,omitempty"`
+	Laugh      *int    `json:"laugh,omitempty"`
+	Confused   *int    `json:"confused,omitempty"`
+	Heart      *int    `json:"heart,omitempty"`
+	Hooray     *int    `json:"hooray,omitempty"`
+	Rocket     *int    `json:"rocket,omitempty"`
+	Eyes       *int    `json:"eyes,omitempty"`
+	URL        *string `json:"url,omitempty"`
+}
+
+// PullRequestLinks is set on an issue only when the issue is a pull request.
+type PullRequestLinks struct {
+	URL      *string    `json:"url,omitempty"`
+	HTMLURL  *string    `json:"html_url,omitempty"`
+	DiffURL  *string    `json:"diff_url,omitempty"`
+	PatchURL *string    `json:"patch_url,omitempty"`
+	MergedAt *time.Time `json:"merged_at,omitempty"`
 }
 
 type IssueRequest struct {
-	Title     *string   `json:"title,omitempty"`
-	Body      *string   `json:"body,omitempty"`
-	Assignees *[]string `json:"assignees,omitempty"`
-	Milestone *int      `json:"milestone,omitempty"`
-	Labels    *[]string `json:"labels,omitempty"`
-	Assignee  *string   `json:"assignee,omitempty"`
-	State     *string   `json:"state,omitempty"`
+	Title       *string   `json:"title,omitempty"`
+	Body        *string   `json:"body,omitempty"`
+	Assignees   *[]string `json:"assignees,omitempty"`
+	Milestone   *int      `json:"milestone,omitempty"`
+	Labels      *[]string `json:"labels,omitempty"`
+	Assignee    *string   `json:"assignee,omitempty"`
+	State       *string   `json:"state,omitempty"`
+	StateReason *string   `json:"state_reason,omitempty"`
 }
 
 // Create a new issue on the specified repository.
@@ -116,3 +234,91 @@ func (s *IssuesService) Update(owner string, repo string, number int, editedIssu
 
 	return res, resp, nil
 }
+
+// IssueListByRepoOptions specifies the optional parameters to the
+// IssuesService.ListByRepo method.
+type IssueListByRepoOptions struct {
+	// State filters issues based on their state. Possible values are: open,
+	// closed, all. Default is "open".
+	State string `url:"state,omitempty"`
+
+	// Labels filters issues based on their label names.
+	Labels []string `url:"labels,omitempty"`
+
+	// Assignee filters issues based on their assignee. Possible values are a
+	// user name, "none" for issues that are not assigned, or "*" for issues
+	// with any assigned user.
+	Assignee string `url:"assignee,omitempty"`
+
+	// Creator filters issues based on their creator.
+	Creator string `url:"creator,omitempty"`
+
+	// Mentioned filters issues to those mentioned a specific user.
+	Mentioned string `url:"mentioned,omitempty"`
+
+	// Milestone filters issues based on their milestone. Possible values are
+	// a milestone number, "none" for issues with no milestone, "*" for issues
+	// with any milestone.
+	Milestone string `url:"milestone,omitempty"`
+
+	// Sort specifies how to sort issues. Possible values are: created,
+	// updated, and comments. Default value is "created".
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort issues. Possible values are: asc, desc.
+	// Default is "desc".
+	Direction string `url:"direction,omitempty"`
+
+	// Since filters issues by time.
+	Since time.Time `url:"since,omitempty"`
+
+	ListOptions
+}
+
+// ListByRepo lists the issues for the specified repository. Pull requests
+// are returned as well and can be told apart with Issue.IsPullRequest.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/issues?apiVersion=2022-11-28#list-repository-issues
+//
+//meta:operation GET /repos/{owner}/{repo}/issues
+func (s *IssuesService) ListByRepo(ctx context.Context, owner string, repo string, opts *IssueListByRepoOptions) ([]*Issue, *http.Response, error) {
+	const op = "github.issue.listByRepo"
+
+	// prepare list issues request
+	u, err := addOptions(fmt.Sprintf("/repos/%s/%s/issues", owner, repo), opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do list issues
+	var res []*Issue
+	resp, err := s.client.Do(request, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
+
+// RemoveMilestone removes the milestone of an issue. IssueRequest cannot
+// express this as it omits a nil Milestone.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/issues?apiVersion=2022-11-28#update-an-issue
+//
+//meta:operation PATCH /repos/{owner}/{repo}/issues/{issue_number}
+func (s *IssuesService) RemoveMilestone(ctx context.Context, owner string, repo string, number int) (*Issue, *http.Response, error) {
+	const op = "github.issue.removeMilestone"
+
+	body := &struct {
+		Milestone *int `json:"milestone"`
+	}{}
+	res, resp, err := s.send(ctx, http.MethodPatch, fmt.Sprintf("/repos/%s/%s/issues/%d", owner, repo, number), body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
@@ -0,0 +1,42 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type assigneesRequest struct {
+	Assignees []string `json:"assignees"`
+}
+
+// AddAssignees adds up to 10 assignees to an issue. Users already assigned
+// are not replaced.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/assignees?apiVersion=2022-11-28#add-assignees-to-an-issue
+//
+//meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/assignees
+func (s *IssuesService) AddAssignees(ctx context.Context, owner string, repo string, number int, assignees []string) (*Issue, *http.Response, error) {
+	const op = "github.issue.addAssignees"
+
+	res, resp, err := s.send(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/%s/issues/%d/assignees", owner, repo, number), &assigneesRequest{assignees})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// RemoveAssignees removes assignees from an issue.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/assignees?apiVersion=2022-11-28#remove-assignees-from-an-issue
+//
+//meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/assignees
+func (s *IssuesService) RemoveAssignees(ctx context.Context, owner string, repo string, number int, assignees []string) (*Issue, *http.Response, error) {
+	const op = "github.issue.removeAssignees"
+
+	res, resp, err := s.send(ctx, http.MethodDelete, fmt.Sprintf("/repos/%s/%s/issues/%d/assignees", owner, repo, number), &assigneesRequest{assignees})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
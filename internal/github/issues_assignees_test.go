@@ -0,0 +1,62 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_AddAssignees(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/assignees", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(assigneesRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		if want := []string{"octocat"}; !cmp.Equal(v.Assignees, want) {
+			t.Errorf("Issues.AddAssignees() got = %v, want %v", v.Assignees, want)
+		}
+
+		// create test response
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"number": 1, "assignees": [{"login": "octocat"}]}`)
+	}))
+
+	issue, _, err := client.Issues.AddAssignees(context.Background(), "testOwner", "testRepo", 1, []string{"octocat"})
+
+	// check issue
+	want := &Issue{Number: Int(1), Assignees: []*User{{Login: String("octocat")}}}
+	if !cmp.Equal(issue, want) {
+		t.Errorf("Issues.AddAssignees() got = %v, want %v", issue, want)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.AddAssignees() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestIssuesService_RemoveAssignees(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/assignees", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(assigneesRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodDelete)
+
+		// create test response
+		fmt.Fprintf(w, `{"number": 1}`)
+	}))
+
+	_, _, err := client.Issues.RemoveAssignees(context.Background(), "testOwner", "testRepo", 1, []string{"octocat"})
+	assertNilError(t, err)
+}
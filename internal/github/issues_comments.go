@@ -0,0 +1,137 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+type IssueComment struct {
+	ID        *int64     `json:"id,omitempty"`
+	Body      *string    `json:"body,omitempty"`
+	User      *User      `json:"user,omitempty"`
+	HTMLURL   *string    `json:"html_url,omitempty"`
+	IssueURL  *string    `json:"issue_url,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// CreateComment creates a new comment on the specified issue.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/comments?apiVersion=2022-11-28#create-an-issue-comment
+//
+//meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/comments
+func (s *IssuesService) CreateComment(owner string, repo string, number int, comment *IssueComment) (*IssueComment, *http.Response, error) {
+	const op = "github.issue.createComment"
+
+	// prepare create comment request
+	request, err := s.client.NewRequest(
+		http.MethodPost,
+		fmt.Sprintf("/repos/%s/%s/issues/%d/comments", owner, repo, number),
+		comment,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do create comment
+	res := new(IssueComment)
+	resp, err := s.client.Do(request, res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
+
+// IssueListCommentsOptions specifies the optional parameters to the
+// IssuesService.ListComments method.
+type IssueListCommentsOptions struct {
+	// Since filters comments by time.
+	Since time.Time `url:"since,omitempty"`
+
+	ListOptions
+}
+
+// ListComments lists the comments on the specified issue.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/comments?apiVersion=2022-11-28#list-issue-comments
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/comments
+func (s *IssuesService) ListComments(owner string, repo string, number int, opts *IssueListCommentsOptions) ([]*IssueComment, *http.Response, error) {
+	const op = "github.issue.listComments"
+
+	// prepare list comments request
+	u, err := addOptions(fmt.Sprintf("/repos/%s/%s/issues/%d/comments", owner, repo, number), opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	request, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do list comments
+	var res []*IssueComment
+	resp, err := s.client.Do(request, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
+
+// IssueNumber returns the number of the issue the comment belongs to, parsed
+// from its IssueURL, or zero if it is unknown.
+func (c *IssueComment) IssueNumber() int {
+	n, _ := strconv.Atoi(path.Base(c.GetIssueURL()))
+	return n
+}
+
+// IssueListRepositoryCommentsOptions specifies the optional parameters to the
+// IssuesService.ListRepositoryComments method.
+type IssueListRepositoryCommentsOptions struct {
+	// Sort specifies how to sort comments. Possible values are: created,
+	// updated. Default value is "created".
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort comments. Possible values are: asc, desc.
+	// Ignored without Sort.
+	Direction string `url:"direction,omitempty"`
+
+	// Since filters comments by time.
+	Since time.Time `url:"since,omitempty"`
+
+	ListOptions
+}
+
+// ListRepositoryComments lists the comments on all issues of a repository.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/comments?apiVersion=2022-11-28#list-issue-comments-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/comments
+func (s *IssuesService) ListRepositoryComments(ctx context.Context, owner string, repo string, opts *IssueListRepositoryCommentsOptions) ([]*IssueComment, *http.Response, error) {
+	const op = "github.issue.listRepositoryComments"
+
+	// prepare list comments request
+	u, err := addOptions(fmt.Sprintf("/repos/%s/%s/issues/comments", owner, repo), opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do list comments
+	var res []*IssueComment
+	resp, err := s.client.Do(request, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
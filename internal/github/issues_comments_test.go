@@ -0,0 +1,130 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_CreateComment(t *testing.T) {
+	setupTest()
+
+	// Prepare the comment request
+	commentRequest := &IssueComment{
+		Body: String("Duplicate of #2"),
+	}
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/comments", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(IssueComment)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, testHeaderAccept, testDefaultMediaType)
+		testHeader(t, r, testHeaderAPIVersion, testDefaultAPIVersion)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		if !cmp.Equal(v, commentRequest) {
+			t.Errorf("Issues.CreateComment() got = %v, want %v", v, commentRequest)
+		}
+
+		// create test response
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id":10, "body": "Duplicate of #2"}`)
+	}))
+
+	// Create the comment
+	comment, resp, err := client.Issues.CreateComment("testOwner", "testRepo", 1, commentRequest)
+
+	// check comment
+	want := &IssueComment{
+		ID:   Int64(10),
+		Body: String("Duplicate of #2"),
+	}
+	if !cmp.Equal(comment, want) {
+		t.Errorf("Issues.CreateComment() got = %v, want %v", comment, want)
+	}
+
+	// check response
+	if resp != nil && resp.StatusCode != http.StatusCreated {
+		t.Errorf("Issues.CreateComment() got = %v, want %v", resp.StatusCode, http.StatusCreated)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.CreateComment() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestIssuesService_ListComments(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/comments", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		if got := r.URL.Query().Get("page"); got != "2" {
+			t.Errorf("Issues.ListComments() page = %v, want %v", got, "2")
+		}
+
+		// create test response
+		w.Header().Set("Link", `<`+server.URL+`/repos/testOwner/testRepo/issues/1/comments?page=3>; rel="next"`)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `[{"id":1, "body": "first"}, {"id":2, "body": "second"}]`)
+	}))
+
+	opts := &IssueListCommentsOptions{ListOptions: ListOptions{Page: 2}}
+	comments, resp, err := client.Issues.ListComments("testOwner", "testRepo", 1, opts)
+
+	// check comments
+	want := []*IssueComment{
+		{ID: Int64(1), Body: String("first")},
+		{ID: Int64(2), Body: String("second")},
+	}
+	if !cmp.Equal(comments, want) {
+		t.Errorf("Issues.ListComments() got = %v, want %v", comments, want)
+	}
+
+	// check response
+	if got := NextPage(resp); got != 3 {
+		t.Errorf("Issues.ListComments() next page = %v, want %v", got, 3)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.ListComments() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestIssuesService_ListRepositoryComments(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/comments", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("since"), "2026-01-01T00:00:00Z"; got != want {
+			t.Errorf("Issues.ListRepositoryComments() since = %v, want %v", got, want)
+		}
+
+		// create test response
+		fmt.Fprintf(w, `[{"id": 1, "body": "b", "issue_url": "https://api.github.com/repos/testOwner/testRepo/issues/7"}]`)
+	}))
+
+	opts := &IssueListRepositoryCommentsOptions{Since: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	comments, _, err := client.Issues.ListRepositoryComments(context.Background(), "testOwner", "testRepo", opts)
+	assertNilError(t, err)
+
+	// check comments
+	want := []*IssueComment{{ID: Int64(1), Body: String("b"), IssueURL: String("https://api.github.com/repos/testOwner/testRepo/issues/7")}}
+	if !cmp.Equal(comments, want) {
+		t.Errorf("Issues.ListRepositoryComments() got = %v, want %v", comments, want)
+	}
+	if got := comments[0].IssueNumber(); got != 7 {
+		t.Errorf("IssueComment.IssueNumber() got = %v, want %v", got, 7)
+	}
+}
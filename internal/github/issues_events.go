@@ -0,0 +1,156 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// IssueEvent represents an event that occurred on an issue, such as
+// "labeled", "assigned", "closed", "referenced", "renamed" or "milestoned".
+// Only the fields relevant to the type of the event are set.
+type IssueEvent struct {
+	ID        *int64     `json:"id,omitempty"`
+	NodeID    *string    `json:"node_id,omitempty"`
+	URL       *string    `json:"url,omitempty"`
+	Actor     *User      `json:"actor,omitempty"`
+	Event     *string    `json:"event,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	CommitID  *string    `json:"commit_id,omitempty"`
+	CommitURL *string    `json:"commit_url,omitempty"`
+
+	// Issue is set for repository events only.
+	Issue *Issue `json:"issue,omitempty"`
+
+	// Only present on certain events.
+	Label             *Label     `json:"label,omitempty"`
+	Assignee          *User      `json:"assignee,omitempty"`
+	Assigner          *User      `json:"assigner,omitempty"`
+	Milestone         *Milestone `json:"milestone,omitempty"`
+	Rename            *Rename    `json:"rename,omitempty"`
+	StateReason       *string    `json:"state_reason,omitempty"`
+	LockReason        *string    `json:"lock_reason,omitempty"`
+	RequestedReviewer *User      `json:"requested_reviewer,omitempty"`
+}
+
+// Rename contains details for "renamed" events.
+type Rename struct {
+	From *string `json:"from,omitempty"`
+	To   *string `json:"to,omitempty"`
+}
+
+// Timeline represents an item of the timeline of an issue. Besides the
+// IssueEvent types it includes "commented", "cross-referenced" and
+// "committed" items.
+type Timeline struct {
+	ID        *int64     `json:"id,omitempty"`
+	NodeID    *string    `json:"node_id,omitempty"`
+	URL       *string    `json:"url,omitempty"`
+	HTMLURL   *string    `json:"html_url,omitempty"`
+	Actor     *User      `json:"actor,omitempty"`
+	Event     *string    `json:"event,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CommitID  *string    `json:"commit_id,omitempty"`
+	CommitURL *string    `json:"commit_url,omitempty"`
+
+	// Only present on certain events.
+	Label       *Label     `json:"label,omitempty"`
+	Assignee    *User      `json:"assignee,omitempty"`
+	Assigner    *User      `json:"assigner,omitempty"`
+	Milestone   *Milestone `json:"milestone,omitempty"`
+	Rename      *Rename    `json:"rename,omitempty"`
+	StateReason *string    `json:"state_reason,omitempty"`
+	LockReason  *string    `json:"lock_reason,omitempty"`
+
+	// Set on "commented" items.
+	User *User   `json:"user,omitempty"`
+	Body *string `json:"body,omitempty"`
+
+	// Set on "cross-referenced" items.
+	Source *Source `json:"source,omitempty"`
+
+	// Set on "committed" items.
+	SHA     *string       `json:"sha,omitempty"`
+	Message *string       `json:"message,omitempty"`
+	Author  *CommitAuthor `json:"author,omitempty"`
+}
+
+// Source represents the issue or pull request a "cross-referenced" item
+// originates from.
+type Source struct {
+	Type  *string `json:"type,omitempty"`
+	Issue *Issue  `json:"issue,omitempty"`
+}
+
+// CommitAuthor represents the author or committer of a git commit.
+type CommitAuthor struct {
+	Name  *string    `json:"name,omitempty"`
+	Email *string    `json:"email,omitempty"`
+	Date  *time.Time `json:"date,omitempty"`
+}
+
+// ListIssueEvents lists the events of the specified issue.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/events?apiVersion=2022-11-28#list-issue-events
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/events
+func (s *IssuesService) ListIssueEvents(ctx context.Context, owner string, repo string, number int, opts *ListOptions) ([]*IssueEvent, *http.Response, error) {
+	const op = "github.issue.listIssueEvents"
+
+	var res []*IssueEvent
+	resp, err := s.list(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d/events", owner, repo, number), opts, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// ListIssueTimeline lists the timeline of the specified issue.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/timeline?apiVersion=2022-11-28#list-timeline-events-for-an-issue
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/timeline
+func (s *IssuesService) ListIssueTimeline(ctx context.Context, owner string, repo string, number int, opts *ListOptions) ([]*Timeline, *http.Response, error) {
+	const op = "github.issue.listIssueTimeline"
+
+	var res []*Timeline
+	resp, err := s.list(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d/timeline", owner, repo, number), opts, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// ListRepositoryEvents lists the issue events of the specified repository.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/events?apiVersion=2022-11-28#list-issue-events-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/events
+func (s *IssuesService) ListRepositoryEvents(ctx context.Context, owner string, repo string, opts *ListOptions) ([]*IssueEvent, *http.Response, error) {
+	const op = "github.issue.listRepositoryEvents"
+
+	var res []*IssueEvent
+	resp, err := s.list(ctx, fmt.Sprintf("/repos/%s/%s/issues/events", owner, repo), opts, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// list sends a GET request for a page of urlStr and decodes it into res.
+func (s *IssuesService) list(ctx context.Context, urlStr string, opts any, res any) (*http.Response, error) {
+	// prepare list request
+	u, err := addOptions(urlStr, opts)
+	if err != nil {
+		return nil, err
+	}
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// do list
+	return s.client.Do(request, res)
+}
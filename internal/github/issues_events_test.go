@@ -0,0 +1,99 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_ListIssueEvents(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/events", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		if got := r.URL.Query().Get("per_page"); got != "100" {
+			t.Errorf("Issues.ListIssueEvents() per_page = %v, want %v", got, "100")
+		}
+
+		// create test response
+		fmt.Fprintf(w, `[{"id": 1, "event": "labeled", "label": {"name": "bug"}}, {"id": 2, "event": "renamed", "rename": {"from": "a", "to": "b"}}]`)
+	}))
+
+	events, _, err := client.Issues.ListIssueEvents(context.Background(), "testOwner", "testRepo", 1, &ListOptions{PerPage: 100})
+
+	// check events
+	want := []*IssueEvent{
+		{ID: Int64(1), Event: String("labeled"), Label: &Label{Name: String("bug")}},
+		{ID: Int64(2), Event: String("renamed"), Rename: &Rename{From: String("a"), To: String("b")}},
+	}
+	if !cmp.Equal(events, want) {
+		t.Errorf("Issues.ListIssueEvents() got = %v, want %v", events, want)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.ListIssueEvents() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestIssuesService_ListIssueTimeline(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/timeline", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		// create test response
+		fmt.Fprintf(w, `[
+			{"event": "commented", "user": {"login": "octocat"}, "body": "hi"},
+			{"event": "cross-referenced", "source": {"type": "issue", "issue": {"number": 7}}}
+		]`)
+	}))
+
+	timeline, _, err := client.Issues.ListIssueTimeline(context.Background(), "testOwner", "testRepo", 1, nil)
+
+	// check timeline
+	want := []*Timeline{
+		{Event: String("commented"), User: &User{Login: String("octocat")}, Body: String("hi")},
+		{Event: String("cross-referenced"), Source: &Source{Type: String("issue"), Issue: &Issue{Number: Int(7)}}},
+	}
+	if !cmp.Equal(timeline, want) {
+		t.Errorf("Issues.ListIssueTimeline() got = %v, want %v", timeline, want)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.ListIssueTimeline() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestIssuesService_ListRepositoryEvents(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/events", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		// create test response
+		fmt.Fprintf(w, `[{"id": 1, "event": "closed", "issue": {"number": 3}}]`)
+	}))
+
+	events, _, err := client.Issues.ListRepositoryEvents(context.Background(), "testOwner", "testRepo", nil)
+
+	// check events
+	want := []*IssueEvent{{ID: Int64(1), Event: String("closed"), Issue: &Issue{Number: Int(3)}}}
+	if !cmp.Equal(events, want) {
+		t.Errorf("Issues.ListRepositoryEvents() got = %v, want %v", events, want)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.ListRepositoryEvents() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
@@ -0,0 +1,121 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type labelsRequest struct {
+	Labels []string `json:"labels"`
+}
+
+// ListLabels lists the labels of a repository.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/labels?apiVersion=2022-11-28#list-labels-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/labels
+func (s *IssuesService) ListLabels(ctx context.Context, owner string, repo string, opts *ListOptions) ([]*Label, *http.Response, error) {
+	const op = "github.issue.listLabels"
+
+	// prepare list labels request
+	u, err := addOptions(fmt.Sprintf("/repos/%s/%s/labels", owner, repo), opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do list labels
+	var res []*Label
+	resp, err := s.client.Do(request, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
+
+// CreateLabel creates a label in a repository.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/labels?apiVersion=2022-11-28#create-a-label
+//
+//meta:operation POST /repos/{owner}/{repo}/labels
+func (s *IssuesService) CreateLabel(ctx context.Context, owner string, repo string, label *Label) (*Label, *http.Response, error) {
+	const op = "github.issue.createLabel"
+
+	// prepare create label request
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/%s/labels", owner, repo), label)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do create label
+	res := new(Label)
+	resp, err := s.client.Do(request, res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
+
+// AddLabelsToIssue adds labels to an issue and returns all its labels.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/labels?apiVersion=2022-11-28#add-labels-to-an-issue
+//
+//meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/labels
+func (s *IssuesService) AddLabelsToIssue(ctx context.Context, owner string, repo string, number int, labels []string) ([]*Label, *http.Response, error) {
+	const op = "github.issue.addLabelsToIssue"
+
+	// prepare add labels request
+	request, err := s.client.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("/repos/%s/%s/issues/%d/labels", owner, repo, number),
+		&labelsRequest{labels},
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do add labels
+	var res []*Label
+	resp, err := s.client.Do(request, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
+
+// RemoveLabelForIssue removes a label from an issue.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/labels?apiVersion=2022-11-28#remove-a-label-from-an-issue
+//
+//meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/labels/{name}
+func (s *IssuesService) RemoveLabelForIssue(ctx context.Context, owner string, repo string, number int, label string) (*http.Response, error) {
+	const op = "github.issue.removeLabelForIssue"
+
+	// prepare remove label request
+	request, err := s.client.NewRequestWithContext(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/repos/%s/%s/issues/%d/labels/%s", owner, repo, number, url.PathEscape(label)),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do remove label
+	resp, err := s.client.Do(request, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_AddLabelsToIssue(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/labels", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(labelsRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		if want := []string{"bug", "ui"}; !cmp.Equal(v.Labels, want) {
+			t.Errorf("Issues.AddLabelsToIssue() got = %v, want %v", v.Labels, want)
+		}
+
+		// create test response
+		fmt.Fprintf(w, `[{"name": "bug"}, {"name": "ui"}]`)
+	}))
+
+	labels, _, err := client.Issues.AddLabelsToIssue(context.Background(), "testOwner", "testRepo", 1, []string{"bug", "ui"})
+
+	// check labels
+	want := []*Label{{Name: String("bug")}, {Name: String("ui")}}
+	if !cmp.Equal(labels, want) {
+		t.Errorf("Issues.AddLabelsToIssue() got = %v, want %v", labels, want)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.AddLabelsToIssue() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestIssuesService_RemoveLabelForIssue(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/labels/needs triage", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+
+		// create test response
+		fmt.Fprintf(w, `[]`)
+	}))
+
+	_, err := client.Issues.RemoveLabelForIssue(context.Background(), "testOwner", "testRepo", 1, "needs triage")
+	assertNilError(t, err)
+}
+
+func TestIssuesService_RemoveMilestone(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := map[string]any{}
+		assertNilError(t, json.NewDecoder(r.Body).Decode(&v))
+
+		testMethod(t, r, http.MethodPatch)
+
+		if m, ok := v["milestone"]; !ok || m != nil {
+			t.Errorf("Issues.RemoveMilestone() got = %v, want milestone null", v)
+		}
+
+		// create test response
+		fmt.Fprintf(w, `{"number": 1}`)
+	}))
+
+	_, _, err := client.Issues.RemoveMilestone(context.Background(), "testOwner", "testRepo", 1)
+	assertNilError(t, err)
+}
+
+func TestIssuesService_ListLabels(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/labels", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("per_page"), "100"; got != want {
+			t.Errorf("Issues.ListLabels() per_page = %v, want %v", got, want)
+		}
+
+		// create test response
+		fmt.Fprintf(w, `[{"name": "bug", "color": "d73a4a"}]`)
+	}))
+
+	labels, _, err := client.Issues.ListLabels(context.Background(), "testOwner", "testRepo", &ListOptions{PerPage: 100})
+	assertNilError(t, err)
+
+	// check labels
+	want := []*Label{{Name: String("bug"), Color: String("d73a4a")}}
+	if !cmp.Equal(labels, want) {
+		t.Errorf("Issues.ListLabels() got = %v, want %v", labels, want)
+	}
+}
+
+func TestIssuesService_CreateLabel(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/labels", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(Label)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPost)
+
+		if want := (&Label{Name: String("bug"), Color: String("d73a4a")}); !cmp.Equal(v, want) {
+			t.Errorf("Issues.CreateLabel() got = %v, want %v", v, want)
+		}
+
+		// create test response
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id": 1, "name": "bug", "color": "d73a4a"}`)
+	}))
+
+	label, resp, err := client.Issues.CreateLabel(context.Background(), "testOwner", "testRepo", &Label{Name: String("bug"), Color: String("d73a4a")})
+	assertNilError(t, err)
+
+	// check label
+	want := &Label{ID: Int64(1), Name: String("bug"), Color: String("d73a4a")}
+	if !cmp.Equal(label, want) {
+		t.Errorf("Issues.CreateLabel() got = %v, want %v", label, want)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Issues.CreateLabel() status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+}
@@ -0,0 +1,112 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// LockReasons lists the reasons accepted by IssuesService.Lock.
+var LockReasons = []string{"off-topic", "too heated", "resolved", "spam"}
+
+type lockIssueRequest struct {
+	LockReason string `json:"lock_reason,omitempty"`
+}
+
+// Lock an issue's conversation. Only users with push access can comment on a
+// locked issue. The reason is optional and must be one of LockReasons.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/issues?apiVersion=2022-11-28#lock-an-issue
+//
+//meta:operation PUT /repos/{owner}/{repo}/issues/{issue_number}/lock
+func (s *IssuesService) Lock(ctx context.Context, owner string, repo string, number int, reason string) (*http.Response, error) {
+	const op = "github.issue.lock"
+
+	// prepare lock issue request
+	var body any
+	if reason != "" {
+		body = &lockIssueRequest{reason}
+	}
+	request, err := s.client.NewRequestWithContext(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("/repos/%s/%s/issues/%d/lock", owner, repo, number),
+		body,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do lock issue
+	resp, err := s.client.Do(request, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return resp, nil
+}
+
+// Unlock an issue's conversation.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/issues?apiVersion=2022-11-28#unlock-an-issue
+//
+//meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/lock
+func (s *IssuesService) Unlock(ctx context.Context, owner string, repo string, number int) (*http.Response, error) {
+	const op = "github.issue.unlock"
+
+	// prepare unlock issue request
+	request, err := s.client.NewRequestWithContext(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/repos/%s/%s/issues/%d/lock", owner, repo, number),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do unlock issue
+	resp, err := s.client.Do(request, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return resp, nil
+}
+
+const (
+	pinIssueMutation = `mutation($issueId: ID!) {
+  pinIssue(input: {issueId: $issueId}) { issue { number } }
+}`
+	unpinIssueMutation = `mutation($issueId: ID!) {
+  unpinIssue(input: {issueId: $issueId}) { issue { number } }
+}`
+)
+
+// Pin an issue to the repository's issue list. The REST API has no endpoint
+// for pinning, so the GraphQL pinIssue mutation is used with the node ID of
+// the issue.
+//
+// GITHUB-API docs: https://docs.github.com/en/graphql/reference/mutations#pinissue
+func (s *IssuesService) Pin(ctx context.Context, nodeID string) (*GraphQLResponse, error) {
+	const op = "github.issue.pin"
+
+	resp, err := s.client.GraphQL(ctx, pinIssueMutation, map[string]any{"issueId": nodeID}, nil)
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", op, err)
+	}
+	return resp, nil
+}
+
+// Unpin an issue from the repository's issue list.
+//
+// GITHUB-API docs: https://docs.github.com/en/graphql/reference/mutations#unpinissue
+func (s *IssuesService) Unpin(ctx context.Context, nodeID string) (*GraphQLResponse, error) {
+	const op = "github.issue.unpin"
+
+	resp, err := s.client.GraphQL(ctx, unpinIssueMutation, map[string]any{"issueId": nodeID}, nil)
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", op, err)
+	}
+	return resp, nil
+}
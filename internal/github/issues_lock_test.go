@@ -0,0 +1,103 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_Lock(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/lock", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(lockIssueRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPut)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		if want := (&lockIssueRequest{"too heated"}); !cmp.Equal(v, want) {
+			t.Errorf("Issues.Lock() got = %v, want %v", v, want)
+		}
+
+		// create test response
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	resp, err := client.Issues.Lock(context.Background(), "testOwner", "testRepo", 1, "too heated")
+
+	// check response
+	if resp != nil && resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Issues.Lock() got = %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.Lock() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestIssuesService_Unlock(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/lock", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		// create test response
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	resp, err := client.Issues.Unlock(context.Background(), "testOwner", "testRepo", 1)
+
+	// check response
+	if resp != nil && resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Issues.Unlock() got = %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.Unlock() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestIssuesService_Pin(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/graphql", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		if v.Query != pinIssueMutation || v.Variables["issueId"] != "I_1" {
+			t.Errorf("Issues.Pin() got = %v, want issueId %v", v, "I_1")
+		}
+
+		// create test response
+		fmt.Fprintf(w, `{"data": {"pinIssue": {"issue": {"number": 1}}}}`)
+	}))
+
+	_, err := client.Issues.Pin(context.Background(), "I_1")
+	assertNilError(t, err)
+}
+
+func TestIssuesService_Pin_GraphQLError(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/graphql", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// create test response
+		fmt.Fprintf(w, `{"data": null, "errors": [{"type": "FORBIDDEN", "message": "Resource not accessible"}]}`)
+	}))
+
+	if _, err := client.Issues.Pin(context.Background(), "I_1"); err == nil {
+		t.Errorf("Issues.Pin() error = %v, wantErr %v", err, true)
+	}
+}
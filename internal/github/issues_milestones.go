@@ -0,0 +1,77 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MilestoneListOptions specifies the optional parameters to the
+// IssuesService.ListMilestones method.
+type MilestoneListOptions struct {
+	// State filters milestones based on their state. Possible values are:
+	// open, closed, all. Default is "open".
+	State string `url:"state,omitempty"`
+
+	// Sort specifies how to sort milestones. Possible values are: due_on,
+	// completeness. Default value is "due_on".
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort milestones. Possible values are: asc, desc.
+	// Default is "asc".
+	Direction string `url:"direction,omitempty"`
+
+	ListOptions
+}
+
+// ListMilestones lists the milestones of a repository.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/milestones?apiVersion=2022-11-28#list-milestones
+//
+//meta:operation GET /repos/{owner}/{repo}/milestones
+func (s *IssuesService) ListMilestones(ctx context.Context, owner string, repo string, opts *MilestoneListOptions) ([]*Milestone, *http.Response, error) {
+	const op = "github.issue.listMilestones"
+
+	// prepare list milestones request
+	u, err := addOptions(fmt.Sprintf("/repos/%s/%s/milestones", owner, repo), opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do list milestones
+	var res []*Milestone
+	resp, err := s.client.Do(request, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
+
+// CreateMilestone creates a milestone in a repository.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/milestones?apiVersion=2022-11-28#create-a-milestone
+//
+//meta:operation POST /repos/{owner}/{repo}/milestones
+func (s *IssuesService) CreateMilestone(ctx context.Context, owner string, repo string, milestone *Milestone) (*Milestone, *http.Response, error) {
+	const op = "github.issue.createMilestone"
+
+	// prepare create milestone request
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/%s/milestones", owner, repo), milestone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do create milestone
+	res := new(Milestone)
+	resp, err := s.client.Do(request, res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
@@ -0,0 +1,62 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_ListMilestones(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/milestones", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("state"), "all"; got != want {
+			t.Errorf("Issues.ListMilestones() state = %v, want %v", got, want)
+		}
+
+		// create test response
+		fmt.Fprintf(w, `[{"number": 3, "title": "v1.0"}]`)
+	}))
+
+	milestones, _, err := client.Issues.ListMilestones(context.Background(), "testOwner", "testRepo", &MilestoneListOptions{State: "all"})
+	assertNilError(t, err)
+
+	// check milestones
+	want := []*Milestone{{Number: Int(3), Title: String("v1.0")}}
+	if !cmp.Equal(milestones, want) {
+		t.Errorf("Issues.ListMilestones() got = %v, want %v", milestones, want)
+	}
+}
+
+func TestIssuesService_CreateMilestone(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/milestones", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(Milestone)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPost)
+
+		if want := (&Milestone{Title: String("v1.0")}); !cmp.Equal(v, want) {
+			t.Errorf("Issues.CreateMilestone() got = %v, want %v", v, want)
+		}
+
+		// create test response
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"number": 4, "title": "v1.0"}`)
+	}))
+
+	milestone, _, err := client.Issues.CreateMilestone(context.Background(), "testOwner", "testRepo", &Milestone{Title: String("v1.0")})
+	assertNilError(t, err)
+
+	// check milestone
+	want := &Milestone{Number: Int(4), Title: String("v1.0")}
+	if !cmp.Equal(milestone, want) {
+		t.Errorf("Issues.CreateMilestone() got = %v, want %v", milestone, want)
+	}
+}
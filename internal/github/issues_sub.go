@@ -0,0 +1,108 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SubIssuesSummary counts the sub-issues of an issue.
+type SubIssuesSummary struct {
+	Total            *int `json:"total,omitempty"`
+	Completed        *int `json:"completed,omitempty"`
+	PercentCompleted *int `json:"percent_completed,omitempty"`
+}
+
+// SubIssueRequest identifies a sub-issue by its ID, not its number.
+type SubIssueRequest struct {
+	SubIssueID int64 `json:"sub_issue_id"`
+
+	// ReplaceParent moves the sub-issue away from its current parent.
+	// Only used by IssuesService.AddSubIssue.
+	ReplaceParent *bool `json:"replace_parent,omitempty"`
+
+	// AfterID or BeforeID positions the sub-issue next to another sub-issue.
+	// Only used by IssuesService.ReprioritizeSubIssue.
+	AfterID  *int64 `json:"after_id,omitempty"`
+	BeforeID *int64 `json:"before_id,omitempty"`
+}
+
+// ListSubIssues lists the sub-issues of the specified issue.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/sub-issues?apiVersion=2022-11-28#list-sub-issues
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/sub_issues
+func (s *IssuesService) ListSubIssues(ctx context.Context, owner string, repo string, number int, opts *ListOptions) ([]*Issue, *http.Response, error) {
+	const op = "github.issue.listSubIssues"
+
+	var res []*Issue
+	resp, err := s.list(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d/sub_issues", owner, repo, number), opts, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// AddSubIssue adds a sub-issue to the specified issue and returns the parent.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/sub-issues?apiVersion=2022-11-28#add-sub-issue
+//
+//meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/sub_issues
+func (s *IssuesService) AddSubIssue(ctx context.Context, owner string, repo string, number int, subIssue *SubIssueRequest) (*Issue, *http.Response, error) {
+	const op = "github.issue.addSubIssue"
+
+	res, resp, err := s.send(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/%s/issues/%d/sub_issues", owner, repo, number), subIssue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// RemoveSubIssue removes a sub-issue from the specified issue and returns the
+// parent.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/sub-issues?apiVersion=2022-11-28#remove-sub-issue
+//
+//meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/sub_issue
+func (s *IssuesService) RemoveSubIssue(ctx context.Context, owner string, repo string, number int, subIssue *SubIssueRequest) (*Issue, *http.Response, error) {
+	const op = "github.issue.removeSubIssue"
+
+	res, resp, err := s.send(ctx, http.MethodDelete, fmt.Sprintf("/repos/%s/%s/issues/%d/sub_issue", owner, repo, number), subIssue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// ReprioritizeSubIssue moves a sub-issue before or after another sub-issue of
+// the specified issue and returns the parent.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/issues/sub-issues?apiVersion=2022-11-28#reprioritize-sub-issue
+//
+//meta:operation PATCH /repos/{owner}/{repo}/issues/{issue_number}/sub_issues/priority
+func (s *IssuesService) ReprioritizeSubIssue(ctx context.Context, owner string, repo string, number int, subIssue *SubIssueRequest) (*Issue, *http.Response, error) {
+	const op = "github.issue.reprioritizeSubIssue"
+
+	res, resp, err := s.send(ctx, http.MethodPatch, fmt.Sprintf("/repos/%s/%s/issues/%d/sub_issues/priority", owner, repo, number), subIssue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// send sends a request with body to urlStr and decodes the returned issue.
+func (s *IssuesService) send(ctx context.Context, method string, urlStr string, body any) (*Issue, *http.Response, error) {
+	// prepare request
+	request, err := s.client.NewRequestWithContext(ctx, method, urlStr, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// do request
+	res := new(Issue)
+	resp, err := s.client.Do(request, res)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, resp, nil
+}
@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_ListSubIssues(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/sub_issues", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		// create test response
+		fmt.Fprintf(w, `[{"number": 2, "state": "closed"}, {"number": 3, "state": "open"}]`)
+	}))
+
+	issues, _, err := client.Issues.ListSubIssues(context.Background(), "testOwner", "testRepo", 1, nil)
+
+	// check issues
+	want := []*Issue{
+		{Number: Int(2), State: String("closed")},
+		{Number: Int(3), State: String("open")},
+	}
+	if !cmp.Equal(issues, want) {
+		t.Errorf("Issues.ListSubIssues() got = %v, want %v", issues, want)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.ListSubIssues() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestIssuesService_AddSubIssue(t *testing.T) {
+	setupTest()
+
+	subIssue := &SubIssueRequest{SubIssueID: 42, ReplaceParent: Bool(true)}
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/sub_issues", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(SubIssueRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		if !cmp.Equal(v, subIssue) {
+			t.Errorf("Issues.AddSubIssue() got = %v, want %v", v, subIssue)
+		}
+
+		// create test response
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"number": 1, "sub_issues_summary": {"total": 1, "completed": 0, "percent_completed": 0}}`)
+	}))
+
+	parent, _, err := client.Issues.AddSubIssue(context.Background(), "testOwner", "testRepo", 1, subIssue)
+
+	// check parent
+	want := &Issue{Number: Int(1), SubIssuesSummary: &SubIssuesSummary{Total: Int(1), Completed: Int(0), PercentCompleted: Int(0)}}
+	if !cmp.Equal(parent, want) {
+		t.Errorf("Issues.AddSubIssue() got = %v, want %v", parent, want)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.AddSubIssue() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestIssuesService_RemoveSubIssue(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/sub_issue", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+
+		// create test response
+		fmt.Fprintf(w, `{"number": 1}`)
+	}))
+
+	_, _, err := client.Issues.RemoveSubIssue(context.Background(), "testOwner", "testRepo", 1, &SubIssueRequest{SubIssueID: 42})
+	assertNilError(t, err)
+}
+
+func TestIssuesService_ReprioritizeSubIssue(t *testing.T) {
+	setupTest()
+
+	subIssue := &SubIssueRequest{SubIssueID: 42, AfterID: Int64(41)}
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/sub_issues/priority", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(SubIssueRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPatch)
+
+		if !cmp.Equal(v, subIssue) {
+			t.Errorf("Issues.ReprioritizeSubIssue() got = %v, want %v", v, subIssue)
+		}
+
+		// create test response
+		fmt.Fprintf(w, `{"number": 1}`)
+	}))
+
+	_, _, err := client.Issues.ReprioritizeSubIssue(context.Background(), "testOwner", "testRepo", 1, subIssue)
+	assertNilError(t, err)
+}
+
+func TestParseTaskList(t *testing.T) {
+	body := "Epic\r\n\r\n- [ ] #12\n- [x] octo/other#7 done elsewhere\n  * [X] https://github.com/o/r/issues/3\n- [ ] write docs\n- not a task\n```\n- [ ] #99\n```\n"
+
+	want := []*TaskListItem{
+		{Checked: false, Text: "#12", Number: 12},
+		{Checked: true, Text: "octo/other#7 done elsewhere", Owner: "octo", Repo: "other", Number: 7},
+		{Checked: true, Text: "https://github.com/o/r/issues/3", Owner: "o", Repo: "r", Number: 3},
+		{Checked: false, Text: "write docs"},
+	}
+	if got := ParseTaskList(body); !cmp.Equal(got, want) {
+		t.Errorf("ParseTaskList() got = %v, want %v", got, want)
+	}
+}
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/google/go-cmp/cmp"
@@ -153,3 +154,53 @@ func TestIssuesService_Create(t *testing.T) {
 		return
 	}
 }
+
+func TestIssuesService_ListByRepo(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, testHeaderAccept, testDefaultMediaType)
+		testHeader(t, r, testHeaderAPIVersion, testDefaultAPIVersion)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		want := map[string]string{"state": "all", "labels": "bug,ui", "creator": "octocat", "per_page": "50"}
+		for k, v := range want {
+			if got := r.URL.Query().Get(k); got != v {
+				t.Errorf("Issues.ListByRepo() %s = %q, want %q", k, got, v)
+			}
+		}
+
+		// create test response
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `[{"number":1, "title": "Issue"}, {"number":2, "title": "PR", "pull_request": {}}]`)
+	}))
+
+	opts := &IssueListByRepoOptions{
+		State:       "all",
+		Labels:      []string{"bug", "ui"},
+		Creator:     "octocat",
+		ListOptions: ListOptions{PerPage: 50},
+	}
+	issues, resp, err := client.Issues.ListByRepo(context.Background(), "testOwner", "testRepo", opts)
+
+	// check issues
+	want := []*Issue{
+		{Number: Int(1), Title: String("Issue")},
+		{Number: Int(2), Title: String("PR"), PullRequestLinks: &PullRequestLinks{}},
+	}
+	if !cmp.Equal(issues, want) {
+		t.Errorf("Issues.ListByRepo() got = %v, want %v", issues, want)
+	}
+
+	// check response
+	if resp != nil && resp.StatusCode != http.StatusOK {
+		t.Errorf("Issues.ListByRepo() got = %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.ListByRepo() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
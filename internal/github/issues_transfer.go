@@ -0,0 +1,46 @@
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+const transferIssueMutation = `mutation($issueId: ID!, $repositoryId: ID!, $createLabelsIfMissing: Boolean) {
+  transferIssue(input: {issueId: $issueId, repositoryId: $repositoryId, createLabelsIfMissing: $createLabelsIfMissing}) {
+    issue { number url }
+  }
+}`
+
+// TransferredIssue is the issue created in the target repository by a transfer.
+type TransferredIssue struct {
+	Number int    `json:"number"`
+	URL    string `json:"url"`
+}
+
+// Transfer moves an issue to another repository the caller can push to. The
+// REST API has no endpoint for transfers, so the GraphQL transferIssue
+// mutation is used with the node IDs of the issue and the target repository.
+// Labels whose names exist in the target are kept; with createLabels the
+// missing ones are created there.
+//
+// GITHUB-API docs: https://docs.github.com/en/graphql/reference/mutations#transferissue
+func (s *IssuesService) Transfer(ctx context.Context, issueNodeID string, repoNodeID string, createLabels bool) (*TransferredIssue, *GraphQLResponse, error) {
+	const op = "github.issue.transfer"
+
+	variables := map[string]any{
+		"issueId":               issueNodeID,
+		"repositoryId":          repoNodeID,
+		"createLabelsIfMissing": createLabels,
+	}
+	var res struct {
+		TransferIssue struct {
+			Issue *TransferredIssue `json:"issue"`
+		} `json:"transferIssue"`
+	}
+	resp, err := s.client.GraphQL(ctx, transferIssueMutation, variables, &res)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res.TransferIssue.Issue, resp, nil
+}
@@ -0,0 +1,45 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_Transfer(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/graphql", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		want := map[string]any{"issueId": "I_1", "repositoryId": "R_2", "createLabelsIfMissing": true}
+		if !cmp.Equal(v.Variables, want) {
+			t.Errorf("Issues.Transfer() got = %v, want %v", v.Variables, want)
+		}
+
+		// create test response
+		fmt.Fprintf(w, `{"data": {"transferIssue": {"issue": {"number": 42, "url": "https://github.com/o/r2/issues/42"}}}}`)
+	}))
+
+	issue, _, err := client.Issues.Transfer(context.Background(), "I_1", "R_2", true)
+
+	// check issue
+	want := &TransferredIssue{Number: 42, URL: "https://github.com/o/r2/issues/42"}
+	if !cmp.Equal(issue, want) {
+		t.Errorf("Issues.Transfer() got = %v, want %v", issue, want)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Issues.Transfer() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
@@ -0,0 +1,124 @@
+package github
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// maxLoggedBody is the number of body bytes logged at debug level.
+const maxLoggedBody = 64 << 10
+
+// redactedHeaders lists the headers whose values are never logged.
+var redactedHeaders = []string{headerAuthorization, "Cookie", "Set-Cookie"}
+
+// loggingTransport logs every request sent through next with its status,
+// timing and rate limit. At debug level headers and bodies are logged too.
+func loggingTransport(next http.RoundTripper, logger *slog.Logger) http.RoundTripper {
+	return roundTripperFunc(
+		func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			debug := logger.Enabled(ctx, slog.LevelDebug)
+
+			if debug {
+				body, err := peekRequestBody(req)
+				if err != nil {
+					return nil, err
+				}
+				logger.DebugContext(ctx, "http request",
+					slog.String("method", req.Method),
+					slog.String("url", req.URL.String()),
+					slog.Any("headers", redactHeaders(req.Header)),
+					slog.String("body", string(body)),
+				)
+			}
+
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+			elapsed := time.Since(start)
+			if err != nil {
+				logger.ErrorContext(ctx, "http request failed",
+					slog.String("method", req.Method),
+					slog.String("url", req.URL.String()),
+					slog.Duration("duration", elapsed),
+					slog.String("error", err.Error()),
+				)
+				return nil, err
+			}
+
+			attrs := []any{
+				slog.String("method", req.Method),
+				slog.String("url", req.URL.String()),
+				slog.Int("status", resp.StatusCode),
+				slog.Duration("duration", elapsed),
+			}
+			if rate, ok := ParseRate(resp); ok {
+				attrs = append(attrs, slog.Group("rate_limit",
+					slog.Int("limit", rate.Limit),
+					slog.Int("remaining", rate.Remaining),
+					slog.Time("reset", rate.Reset),
+				))
+			}
+			if debug {
+				body, err := peekResponseBody(resp)
+				if err != nil {
+					return nil, err
+				}
+				attrs = append(attrs,
+					slog.Any("headers", redactHeaders(resp.Header)),
+					slog.String("body", string(body)),
+				)
+			}
+			logger.InfoContext(ctx, "http response", attrs...)
+
+			return resp, nil
+		},
+	)
+}
+
+// peekRequestBody returns the start of the request body, leaving the body
+// readable for the transport.
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return truncateBody(data), nil
+}
+
+// peekResponseBody returns the start of the response body, leaving the body
+// readable for the caller.
+func peekResponseBody(resp *http.Response) ([]byte, error) {
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return truncateBody(data), nil
+}
+
+func truncateBody(data []byte) []byte {
+	if len(data) > maxLoggedBody {
+		return append(data[:maxLoggedBody:maxLoggedBody], "…"...)
+	}
+	return data
+}
+
+// redactHeaders returns a copy of h with credentials replaced.
+func redactHeaders(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range redactedHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, "REDACTED")
+		}
+	}
+	return h
+}
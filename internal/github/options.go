@@ -0,0 +1,115 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListOptions specifies the optional parameters to various List methods that
+// support offset pagination.
+type ListOptions struct {
+	// For paginated result sets, page of results to retrieve.
+	Page int `url:"page,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// addOptions adds the parameters in opts as URL query parameters to s. opts
+// must be a struct whose fields contain "url" tags.
+func addOptions(s string, opts any) (string, error) {
+	v := reflect.ValueOf(opts)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return s, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+
+	qs := u.Query()
+	if err := encodeValues(qs, reflect.Indirect(v)); err != nil {
+		return s, err
+	}
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
+
+// encodeValues walks the struct fields of v and stores them in qs by their
+// "url" tag name. Embedded structs are flattened.
+func encodeValues(qs url.Values, v reflect.Value) error {
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("options must be a struct, got %s", v.Kind())
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, value := t.Field(i), v.Field(i)
+		if field.Anonymous && value.Kind() == reflect.Struct {
+			if err := encodeValues(qs, value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		tag := field.Tag.Get("url")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name, omitEmpty, _ := strings.Cut(tag, ",")
+		if omitEmpty == "omitempty" && value.IsZero() {
+			continue
+		}
+
+		switch x := value.Interface().(type) {
+		case time.Time:
+			qs.Set(name, x.Format(time.RFC3339))
+		case []string:
+			qs.Set(name, strings.Join(x, ","))
+		case string:
+			qs.Set(name, x)
+		case int:
+			qs.Set(name, strconv.Itoa(x))
+		case int64:
+			qs.Set(name, strconv.FormatInt(x, 10))
+		case bool:
+			qs.Set(name, strconv.FormatBool(x))
+		default:
+			return fmt.Errorf("unsupported option type %s for %q", value.Type(), name)
+		}
+	}
+	return nil
+}
+
+var linkNextRegexp = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// NextPageURL returns the URL of the next page of results from the Link
+// header of resp, or an empty string if resp is the last page.
+func NextPageURL(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+	m := linkNextRegexp.FindStringSubmatch(resp.Header.Get("Link"))
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
+
+// NextPage returns the number of the next page of results from the Link
+// header of resp, or zero if resp is the last page.
+func NextPage(resp *http.Response) int {
+	next, err := url.Parse(NextPageURL(resp))
+	if err != nil {
+		return 0
+	}
+	page, _ := strconv.Atoi(next.Query().Get("page"))
+	return page
+}
@@ -0,0 +1,67 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAddOptions(t *testing.T) {
+	type opts struct {
+		State  string    `url:"state,omitempty"`
+		Labels []string  `url:"labels,omitempty"`
+		Since  time.Time `url:"since,omitempty"`
+		Skip   string
+		ListOptions
+	}
+	tests := []struct {
+		name string
+		opts any
+		want string
+	}{
+		{
+			name: "Nil options",
+			opts: (*opts)(nil),
+			want: "repos/o/r/issues",
+		},
+		{
+			name: "Empty options",
+			opts: &opts{},
+			want: "repos/o/r/issues",
+		},
+		{
+			name: "All options",
+			opts: &opts{
+				State:       "open",
+				Labels:      []string{"bug", "ui"},
+				Since:       time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+				Skip:        "skip",
+				ListOptions: ListOptions{Page: 2, PerPage: 50},
+			},
+			want: "repos/o/r/issues?labels=bug%2Cui&page=2&per_page=50&since=2026-01-02T03%3A04%3A05Z&state=open",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addOptions("repos/o/r/issues", tt.opts)
+			assertNilError(t, err)
+			if got != tt.want {
+				t.Errorf("addOptions() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextPage(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Link", `<https://api.github.com/repositories/1/issues?page=3>; rel="next", <https://api.github.com/repositories/1/issues?page=5>; rel="last"`)
+
+	if got := NextPage(resp); got != 3 {
+		t.Errorf("NextPage() got = %v, want %v", got, 3)
+	}
+
+	resp.Header.Set("Link", `<https://api.github.com/repositories/1/issues?page=1>; rel="first"`)
+	if got := NextPage(resp); got != 0 {
+		t.Errorf("NextPage() got = %v, want %v", got, 0)
+	}
+}
@@ -0,0 +1,63 @@
+package github
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRetryAfter    = "Retry-After"
+)
+
+// Rate represents the rate limit of the client as reported by a response.
+type Rate struct {
+	// The number of requests per hour the client is currently limited to.
+	Limit int
+
+	// The number of remaining requests the client can make this hour.
+	Remaining int
+
+	// The time at which the current rate limit will reset.
+	Reset time.Time
+}
+
+// ParseRate parses the rate limit headers of resp. It reports false when the
+// response has no such headers.
+func ParseRate(resp *http.Response) (Rate, bool) {
+	if resp == nil || resp.Header.Get(headerRateRemaining) == "" {
+		return Rate{}, false
+	}
+
+	var rate Rate
+	rate.Limit, _ = strconv.Atoi(resp.Header.Get(headerRateLimit))
+	rate.Remaining, _ = strconv.Atoi(resp.Header.Get(headerRateRemaining))
+	if reset, err := strconv.ParseInt(resp.Header.Get(headerRateReset), 10, 64); err == nil {
+		rate.Reset = time.Unix(reset, 0)
+	}
+	return rate, true
+}
+
+// RetryAfter returns how long to wait before retrying a request that hit the
+// primary or a secondary rate limit, or zero if resp is not rate limited.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/using-the-rest-api/troubleshooting-the-rest-api?apiVersion=2022-11-28#rate-limit-errors
+func RetryAfter(resp *http.Response) time.Duration {
+	if resp == nil || (resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests) {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get(headerRetryAfter)); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if rate, ok := ParseRate(resp); ok && rate.Remaining == 0 {
+		return max(time.Until(rate.Reset), 0) + time.Second
+	}
+	// secondary limits without a hint should wait at least a minute
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return time.Minute
+	}
+	return 0
+}
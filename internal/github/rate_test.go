@@ -0,0 +1,66 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseRate(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	if _, ok := ParseRate(resp); ok {
+		t.Errorf("ParseRate() ok = %v, want %v", ok, false)
+	}
+
+	resp.Header.Set(headerRateLimit, "5000")
+	resp.Header.Set(headerRateRemaining, "42")
+	resp.Header.Set(headerRateReset, "1767225600")
+	rate, ok := ParseRate(resp)
+
+	want := Rate{Limit: 5000, Remaining: 42, Reset: time.Unix(1767225600, 0)}
+	if !ok || rate != want {
+		t.Errorf("ParseRate() got = %v, want %v", rate, want)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		headers map[string]string
+		want    time.Duration
+	}{
+		{
+			name:   "Not limited",
+			status: http.StatusOK,
+			want:   0,
+		},
+		{
+			name:    "Secondary limit with hint",
+			status:  http.StatusForbidden,
+			headers: map[string]string{headerRetryAfter: "30"},
+			want:    30 * time.Second,
+		},
+		{
+			name:   "Secondary limit without hint",
+			status: http.StatusTooManyRequests,
+			want:   time.Minute,
+		},
+		{
+			name:   "Forbidden for other reasons",
+			status: http.StatusForbidden,
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			for k, v := range tt.headers {
+				resp.Header.Set(k, v)
+			}
+			if got := RetryAfter(resp); got != tt.want {
+				t.Errorf("RetryAfter() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
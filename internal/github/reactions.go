@@ -0,0 +1,178 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+type ReactionsService service
+
+// ReactionContents lists the content types a reaction can have.
+var ReactionContents = []string{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"}
+
+type Reaction struct {
+	ID        *int64     `json:"id,omitempty"`
+	NodeID    *string    `json:"node_id,omitempty"`
+	User      *User      `json:"user,omitempty"`
+	Content   *string    `json:"content,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// ListReactionOptions specifies the optional parameters to the
+// ReactionsService list methods.
+type ListReactionOptions struct {
+	// Content restricts the returned reactions to those with the given type.
+	// Omit this parameter to list all reactions.
+	Content string `url:"content,omitempty"`
+
+	ListOptions
+}
+
+type reactionRequest struct {
+	Content string `json:"content"`
+}
+
+// ListIssueReactions lists the reactions for an issue.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/reactions/reactions?apiVersion=2022-11-28#list-reactions-for-an-issue
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/reactions
+func (s *ReactionsService) ListIssueReactions(ctx context.Context, owner string, repo string, number int, opts *ListReactionOptions) ([]*Reaction, *http.Response, error) {
+	const op = "github.reaction.listIssueReactions"
+
+	res, resp, err := s.list(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d/reactions", owner, repo, number), opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// CreateIssueReaction creates a reaction for an issue. Creating a reaction
+// the user already added returns the existing one with status 200.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/reactions/reactions?apiVersion=2022-11-28#create-reaction-for-an-issue
+//
+//meta:operation POST /repos/{owner}/{repo}/issues/{issue_number}/reactions
+func (s *ReactionsService) CreateIssueReaction(ctx context.Context, owner string, repo string, number int, content string) (*Reaction, *http.Response, error) {
+	const op = "github.reaction.createIssueReaction"
+
+	res, resp, err := s.create(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d/reactions", owner, repo, number), content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// DeleteIssueReaction deletes a reaction of an issue.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/reactions/reactions?apiVersion=2022-11-28#delete-an-issue-reaction
+//
+//meta:operation DELETE /repos/{owner}/{repo}/issues/{issue_number}/reactions/{reaction_id}
+func (s *ReactionsService) DeleteIssueReaction(ctx context.Context, owner string, repo string, number int, reactionID int64) (*http.Response, error) {
+	const op = "github.reaction.deleteIssueReaction"
+
+	resp, err := s.delete(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d/reactions/%d", owner, repo, number, reactionID))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return resp, nil
+}
+
+// ListIssueCommentReactions lists the reactions for an issue comment.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/reactions/reactions?apiVersion=2022-11-28#list-reactions-for-an-issue-comment
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions
+func (s *ReactionsService) ListIssueCommentReactions(ctx context.Context, owner string, repo string, commentID int64, opts *ListReactionOptions) ([]*Reaction, *http.Response, error) {
+	const op = "github.reaction.listIssueCommentReactions"
+
+	res, resp, err := s.list(ctx, fmt.Sprintf("/repos/%s/%s/issues/comments/%d/reactions", owner, repo, commentID), opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// CreateIssueCommentReaction creates a reaction for an issue comment.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/reactions/reactions?apiVersion=2022-11-28#create-reaction-for-an-issue-comment
+//
+//meta:operation POST /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions
+func (s *ReactionsService) CreateIssueCommentReaction(ctx context.Context, owner string, repo string, commentID int64, content string) (*Reaction, *http.Response, error) {
+	const op = "github.reaction.createIssueCommentReaction"
+
+	res, resp, err := s.create(ctx, fmt.Sprintf("/repos/%s/%s/issues/comments/%d/reactions", owner, repo, commentID), content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return res, resp, nil
+}
+
+// DeleteIssueCommentReaction deletes a reaction of an issue comment.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/reactions/reactions?apiVersion=2022-11-28#delete-an-issue-comment-reaction
+//
+//meta:operation DELETE /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions/{reaction_id}
+func (s *ReactionsService) DeleteIssueCommentReaction(ctx context.Context, owner string, repo string, commentID int64, reactionID int64) (*http.Response, error) {
+	const op = "github.reaction.deleteIssueCommentReaction"
+
+	resp, err := s.delete(ctx, fmt.Sprintf("/repos/%s/%s/issues/comments/%d/reactions/%d", owner, repo, commentID, reactionID))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return resp, nil
+}
+
+func (s *ReactionsService) list(ctx context.Context, urlStr string, opts *ListReactionOptions) ([]*Reaction, *http.Response, error) {
+	// prepare list reactions request
+	u, err := addOptions(urlStr, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// do list reactions
+	var res []*Reaction
+	resp, err := s.client.Do(request, &res)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, resp, nil
+}
+
+func (s *ReactionsService) create(ctx context.Context, urlStr string, content string) (*Reaction, *http.Response, error) {
+	if !slices.Contains(ReactionContents, content) {
+		return nil, nil, fmt.Errorf("invalid reaction content %q, expected one of %q", content, ReactionContents)
+	}
+
+	// prepare create reaction request
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodPost, urlStr, &reactionRequest{content})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// do create reaction
+	res := new(Reaction)
+	resp, err := s.client.Do(request, res)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, resp, nil
+}
+
+func (s *ReactionsService) delete(ctx context.Context, urlStr string) (*http.Response, error) {
+	// prepare delete reaction request
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// do delete reaction
+	return s.client.Do(request, nil)
+}
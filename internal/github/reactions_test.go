@@ -0,0 +1,112 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReactionsService_ListIssueReactions(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/reactions", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		if got := r.URL.Query().Get("content"); got != "heart" {
+			t.Errorf("Reactions.ListIssueReactions() content = %v, want %v", got, "heart")
+		}
+
+		// create test response
+		fmt.Fprintf(w, `[{"id": 1, "content": "heart", "user": {"login": "octocat"}}]`)
+	}))
+
+	reactions, _, err := client.Reactions.ListIssueReactions(context.Background(), "testOwner", "testRepo", 1, &ListReactionOptions{Content: "heart"})
+
+	// check reactions
+	want := []*Reaction{{ID: Int64(1), Content: String("heart"), User: &User{Login: String("octocat")}}}
+	if !cmp.Equal(reactions, want) {
+		t.Errorf("Reactions.ListIssueReactions() got = %v, want %v", reactions, want)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Reactions.ListIssueReactions() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestReactionsService_CreateIssueCommentReaction(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/comments/5/reactions", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := new(reactionRequest)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		if v.Content != "+1" {
+			t.Errorf("Reactions.CreateIssueCommentReaction() got = %v, want %v", v.Content, "+1")
+		}
+
+		// create test response
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id": 2, "content": "+1"}`)
+	}))
+
+	reaction, resp, err := client.Reactions.CreateIssueCommentReaction(context.Background(), "testOwner", "testRepo", 5, "+1")
+
+	// check reaction
+	want := &Reaction{ID: Int64(2), Content: String("+1")}
+	if !cmp.Equal(reaction, want) {
+		t.Errorf("Reactions.CreateIssueCommentReaction() got = %v, want %v", reaction, want)
+	}
+
+	// check response
+	if resp != nil && resp.StatusCode != http.StatusCreated {
+		t.Errorf("Reactions.CreateIssueCommentReaction() got = %v, want %v", resp.StatusCode, http.StatusCreated)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Reactions.CreateIssueCommentReaction() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestReactionsService_CreateIssueReaction_InvalidContent(t *testing.T) {
+	setupTest()
+
+	if _, _, err := client.Reactions.CreateIssueReaction(context.Background(), "testOwner", "testRepo", 1, "thumbsup"); err == nil {
+		t.Errorf("Reactions.CreateIssueReaction() error = %v, wantErr %v", err, true)
+	}
+}
+
+func TestReactionsService_DeleteIssueReaction(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo/issues/1/reactions/2", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+
+		// create test response
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	resp, err := client.Reactions.DeleteIssueReaction(context.Background(), "testOwner", "testRepo", 1, 2)
+
+	// check response
+	if resp != nil && resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Reactions.DeleteIssueReaction() got = %v, want %v", resp.StatusCode, http.StatusNoContent)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Reactions.DeleteIssueReaction() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
@@ -0,0 +1,97 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type RepositoriesService service
+
+type Repository struct {
+	ID            *int64     `json:"id,omitempty"`
+	NodeID        *string    `json:"node_id,omitempty"`
+	Name          *string    `json:"name,omitempty"`
+	FullName      *string    `json:"full_name,omitempty"`
+	Owner         *User      `json:"owner,omitempty"`
+	Description   *string    `json:"description,omitempty"`
+	HTMLURL       *string    `json:"html_url,omitempty"`
+	Private       *bool      `json:"private,omitempty"`
+	Fork          *bool      `json:"fork,omitempty"`
+	Archived      *bool      `json:"archived,omitempty"`
+	HasIssues     *bool      `json:"has_issues,omitempty"`
+	OpenIssues    *int       `json:"open_issues_count,omitempty"`
+	DefaultBranch *string    `json:"default_branch,omitempty"`
+	PushedAt      *time.Time `json:"pushed_at,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+// Get fetches a repository.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/repos/repos?apiVersion=2022-11-28#get-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}
+func (s *RepositoriesService) Get(ctx context.Context, owner string, repo string) (*Repository, *http.Response, error) {
+	const op = "github.repository.get"
+
+	// prepare get repository request
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/repos/%s/%s", owner, repo), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do get repository
+	res := new(Repository)
+	resp, err := s.client.Do(request, res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
+
+// RepositoryListByOrgOptions specifies the optional parameters to the
+// RepositoriesService.ListByOrg method.
+type RepositoryListByOrgOptions struct {
+	// Type filters repositories by type. Possible values are: all, public,
+	// private, forks, sources, member. Default is "all".
+	Type string `url:"type,omitempty"`
+
+	// Sort specifies how to sort repositories. Possible values are: created,
+	// updated, pushed, full_name. Default is "created".
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort repositories. Possible values are: asc, desc.
+	Direction string `url:"direction,omitempty"`
+
+	ListOptions
+}
+
+// ListByOrg lists the repositories of an organization.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/repos/repos?apiVersion=2022-11-28#list-organization-repositories
+//
+//meta:operation GET /orgs/{org}/repos
+func (s *RepositoriesService) ListByOrg(ctx context.Context, org string, opts *RepositoryListByOrgOptions) ([]*Repository, *http.Response, error) {
+	const op = "github.repository.listByOrg"
+
+	// prepare list repositories request
+	u, err := addOptions(fmt.Sprintf("/orgs/%s/repos", org), opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do list repositories
+	var res []*Repository
+	resp, err := s.client.Do(request, &res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
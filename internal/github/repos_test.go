@@ -0,0 +1,66 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepositoriesService_Get(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/repos/testOwner/testRepo", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		// create test response
+		fmt.Fprintf(w, `{"id": 1, "node_id": "R_1", "full_name": "testOwner/testRepo"}`)
+	}))
+
+	repo, _, err := client.Repositories.Get(context.Background(), "testOwner", "testRepo")
+
+	// check repository
+	want := &Repository{ID: Int64(1), NodeID: String("R_1"), FullName: String("testOwner/testRepo")}
+	if !cmp.Equal(repo, want) {
+		t.Errorf("Repositories.Get() got = %v, want %v", repo, want)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Repositories.Get() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestRepositoriesService_ListByOrg(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/orgs/testOrg/repos", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("type"), "sources"; got != want {
+			t.Errorf("Repositories.ListByOrg() type = %v, want %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("per_page"), "100"; got != want {
+			t.Errorf("Repositories.ListByOrg() per_page = %v, want %v", got, want)
+		}
+
+		// create test response
+		fmt.Fprintf(w, `[{"id": 1, "full_name": "testOrg/a"}, {"id": 2, "full_name": "testOrg/b", "archived": true}]`)
+	}))
+
+	opts := &RepositoryListByOrgOptions{Type: "sources", ListOptions: ListOptions{PerPage: 100}}
+	repos, _, err := client.Repositories.ListByOrg(context.Background(), "testOrg", opts)
+	assertNilError(t, err)
+
+	// check repositories
+	want := []*Repository{
+		{ID: Int64(1), FullName: String("testOrg/a")},
+		{ID: Int64(2), FullName: String("testOrg/b"), Archived: Bool(true)},
+	}
+	if !cmp.Equal(repos, want) {
+		t.Errorf("Repositories.ListByOrg() got = %v, want %v", repos, want)
+	}
+}
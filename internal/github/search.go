@@ -0,0 +1,66 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type SearchService service
+
+// SearchOptions specifies the optional parameters to the SearchService methods.
+type SearchOptions struct {
+	// How to sort the search results. Possible values for issues are:
+	// comments, reactions, reactions-+1, reactions-heart, created, updated...
+	Sort string `url:"sort,omitempty"`
+
+	// Sort order if sort parameter is provided. Possible values are: asc, desc.
+	Order string `url:"order,omitempty"`
+
+	ListOptions
+}
+
+type IssuesSearchResult struct {
+	Total             *int     `json:"total_count,omitempty"`
+	IncompleteResults *bool    `json:"incomplete_results,omitempty"`
+	Issues            []*Issue `json:"items,omitempty"`
+}
+
+// Issues searches issues and pull requests via various criteria.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/search/search?apiVersion=2022-11-28#search-issues-and-pull-requests
+//
+//meta:operation GET /search/issues
+func (s *SearchService) Issues(ctx context.Context, query string, opts *SearchOptions) (*IssuesSearchResult, *http.Response, error) {
+	const op = "github.search.issues"
+
+	// prepare search issues request
+	u, err := addOptions("/search/issues?"+url.Values{"q": {query}}.Encode(), opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do search issues
+	res := new(IssuesSearchResult)
+	resp, err := s.client.Do(request, res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
+
+// Qualifier formats a search qualifier such as label:bug, quoting the value
+// when it contains whitespace.
+func Qualifier(key string, value string) string {
+	if strings.ContainsAny(value, " \t") && !strings.HasPrefix(value, `"`) {
+		value = `"` + value + `"`
+	}
+	return key + ":" + value
+}
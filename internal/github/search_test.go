@@ -0,0 +1,67 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSearchService_Issues(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/search/issues", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, testHeaderAccept, testDefaultMediaType)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		want := map[string]string{"q": "is:issue label:bug", "sort": "created", "order": "asc", "per_page": "10"}
+		for k, v := range want {
+			if got := r.URL.Query().Get(k); got != v {
+				t.Errorf("Search.Issues() %s = %q, want %q", k, got, v)
+			}
+		}
+
+		// create test response
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"total_count": 4, "incomplete_results": true, "items": [{"number": 1}, {"number": 2}]}`)
+	}))
+
+	opts := &SearchOptions{Sort: "created", Order: "asc", ListOptions: ListOptions{PerPage: 10}}
+	result, _, err := client.Search.Issues(context.Background(), "is:issue label:bug", opts)
+
+	// check result
+	want := &IssuesSearchResult{
+		Total:             Int(4),
+		IncompleteResults: Bool(true),
+		Issues:            []*Issue{{Number: Int(1)}, {Number: Int(2)}},
+	}
+	if !cmp.Equal(result, want) {
+		t.Errorf("Search.Issues() got = %v, want %v", result, want)
+	}
+
+	// check error
+	if err != nil {
+		t.Errorf("Search.Issues() error = %v, wantErr %v", err, nil)
+		return
+	}
+}
+
+func TestQualifier(t *testing.T) {
+	tests := []struct {
+		key   string
+		value string
+		want  string
+	}{
+		{"label", "bug", "label:bug"},
+		{"label", "needs triage", `label:"needs triage"`},
+		{"created", ">=2026-01-01", "created:>=2026-01-01"},
+	}
+	for _, tt := range tests {
+		if got := Qualifier(tt.key, tt.value); got != tt.want {
+			t.Errorf("Qualifier(%q, %q) got = %v, want %v", tt.key, tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TaskListItem is a "- [ ] ..." item of a Markdown task list.
+type TaskListItem struct {
+	Checked bool
+	Text    string
+
+	// Owner, Repo and Number are set when the item references an issue, as
+	// in "- [ ] #123", "- [x] owner/repo#123" or a full issue URL. Owner and
+	// Repo are empty for references within the same repository.
+	Owner  string
+	Repo   string
+	Number int
+}
+
+var (
+	taskListItemRegexp = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.*?)\s*$`)
+	issueRefRegexp     = regexp.MustCompile(`^(?:([\w.-]+)/([\w.-]+))?#(\d+)\b|^https://github\.com/([\w.-]+)/([\w.-]+)/issues/(\d+)\b`)
+)
+
+// ParseTaskList returns the task list items of a Markdown body, skipping
+// those inside fenced code blocks.
+func ParseTaskList(body string) []*TaskListItem {
+	var (
+		items   []*TaskListItem
+		inFence bool
+	)
+	for _, line := range strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		m := taskListItemRegexp.FindStringSubmatch(line)
+		if inFence || m == nil {
+			continue
+		}
+
+		item := &TaskListItem{Checked: m[1] != " ", Text: m[2]}
+		if ref := issueRefRegexp.FindStringSubmatch(m[2]); ref != nil {
+			if ref[3] != "" {
+				item.Owner, item.Repo = ref[1], ref[2]
+				item.Number, _ = strconv.Atoi(ref[3])
+			} else {
+				item.Owner, item.Repo = ref[4], ref[5]
+				item.Number, _ = strconv.Atoi(ref[6])
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
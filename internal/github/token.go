@@ -0,0 +1,104 @@
+package github
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	headerOAuthScopes         = "X-OAuth-Scopes"
+	headerAcceptedOAuthScopes = "X-Accepted-OAuth-Scopes"
+	headerTokenExpiration     = "Github-Authentication-Token-Expiration"
+
+	tokenExpirationLayout = "2006-01-02 15:04:05 MST"
+)
+
+// impliedScopes lists the OAuth scopes granted along with a broader scope.
+//
+// GITHUB-API docs: https://docs.github.com/en/apps/oauth-apps/building-oauth-apps/scopes-for-oauth-apps#available-scopes
+var impliedScopes = map[string][]string{
+	"repo":            {"repo:status", "repo_deployment", "public_repo", "repo:invite", "security_events"},
+	"admin:org":       {"write:org", "read:org"},
+	"write:org":       {"read:org"},
+	"admin:repo_hook": {"write:repo_hook", "read:repo_hook"},
+	"write:repo_hook": {"read:repo_hook"},
+	"admin:org_hook":  {"read:org_hook"},
+	"user":            {"read:user", "user:email", "user:follow"},
+	"write:packages":  {"read:packages"},
+	"admin:gpg_key":   {"write:gpg_key", "read:gpg_key"},
+	"write:gpg_key":   {"read:gpg_key"},
+	"project":         {"read:project"},
+}
+
+// TokenInfo describes the token of the client as reported by a response.
+type TokenInfo struct {
+	// The OAuth scopes of a classic token. Nil for fine-grained and app
+	// tokens, which do not report scopes.
+	Scopes []string
+
+	// The scopes the requested endpoint accepts.
+	AcceptedScopes []string
+
+	// The time at which the token expires, zero if it does not expire or the
+	// response does not say.
+	ExpiresAt time.Time
+}
+
+// ParseTokenInfo parses the scope and expiration headers of resp.
+func ParseTokenInfo(resp *http.Response) TokenInfo {
+	var info TokenInfo
+	if resp == nil {
+		return info
+	}
+	if v, ok := resp.Header[http.CanonicalHeaderKey(headerOAuthScopes)]; ok {
+		info.Scopes = splitScopes(strings.Join(v, ","))
+	}
+	info.AcceptedScopes = splitScopes(resp.Header.Get(headerAcceptedOAuthScopes))
+	if exp, err := time.Parse(tokenExpirationLayout, resp.Header.Get(headerTokenExpiration)); err == nil {
+		info.ExpiresAt = exp
+	}
+	return info
+}
+
+// HasScopes reports whether the token reports its scopes at all.
+func (t TokenInfo) HasScopes() bool {
+	return t.Scopes != nil
+}
+
+// HasScope reports whether the token was granted scope, directly or through a
+// broader scope.
+func (t TokenInfo) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+		for _, implied := range impliedScopes[s] {
+			if implied == scope {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MissingScopes returns the scopes out of want that the token lacks.
+func (t TokenInfo) MissingScopes(want ...string) []string {
+	var missing []string
+	for _, scope := range want {
+		if !t.HasScope(scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
+func splitScopes(s string) []string {
+	scopes := []string{}
+	for _, scope := range strings.Split(s, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
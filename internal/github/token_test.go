@@ -0,0 +1,43 @@
+package github
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseTokenInfo(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	if info := ParseTokenInfo(resp); info.HasScopes() || !info.ExpiresAt.IsZero() {
+		t.Errorf("ParseTokenInfo() got = %+v, want no scopes and no expiration", info)
+	}
+
+	resp.Header.Set(headerOAuthScopes, "")
+	if info := ParseTokenInfo(resp); !info.HasScopes() || len(info.Scopes) != 0 {
+		t.Errorf("ParseTokenInfo() got = %+v, want an empty scope list", info)
+	}
+
+	resp.Header.Set(headerOAuthScopes, "repo, admin:org")
+	resp.Header.Set(headerAcceptedOAuthScopes, "repo")
+	resp.Header.Set(headerTokenExpiration, "2026-11-01 12:00:00 UTC")
+	info := ParseTokenInfo(resp)
+
+	want := TokenInfo{
+		Scopes:         []string{"repo", "admin:org"},
+		AcceptedScopes: []string{"repo"},
+		ExpiresAt:      time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC),
+	}
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("ParseTokenInfo() got = %+v, want %+v", info, want)
+	}
+}
+
+func TestTokenInfo_MissingScopes(t *testing.T) {
+	info := TokenInfo{Scopes: []string{"repo", "admin:org"}}
+
+	got := info.MissingScopes("public_repo", "read:org", "workflow")
+	if want := []string{"workflow"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TokenInfo.MissingScopes() got = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,39 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type UsersService service
+
+// Get fetches a user. Passing the empty string fetches the authenticated user.
+//
+// GITHUB-API docs: https://docs.github.com/en/rest/users/users?apiVersion=2022-11-28#get-the-authenticated-user
+// GITHUB-API docs: https://docs.github.com/en/rest/users/users?apiVersion=2022-11-28#get-a-user
+//
+//meta:operation GET /user
+//meta:operation GET /users/{username}
+func (s *UsersService) Get(ctx context.Context, user string) (*User, *http.Response, error) {
+	const op = "github.user.get"
+
+	// prepare get user request
+	u := "user"
+	if user != "" {
+		u = fmt.Sprintf("users/%s", user)
+	}
+	request, err := s.client.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// do get user
+	res := new(User)
+	resp, err := s.client.Do(request, res)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, resp, nil
+}
@@ -0,0 +1,46 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUsersService_Get(t *testing.T) {
+	setupTest()
+
+	mux.Handle("/user", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, testHeaderAuthorization, "Bearer "+testToken)
+
+		// create test response
+		w.Header().Set("X-OAuth-Scopes", "repo, read:org")
+		fmt.Fprintf(w, `{"id": 1, "login": "octocat"}`)
+	}))
+	mux.Handle("/users/hubot", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		// create test response
+		fmt.Fprintf(w, `{"id": 2, "login": "hubot"}`)
+	}))
+
+	// check authenticated user
+	user, resp, err := client.Users.Get(context.Background(), "")
+	assertNilError(t, err)
+	if want := (&User{ID: Int64(1), Login: String("octocat")}); !cmp.Equal(user, want) {
+		t.Errorf("Users.Get() got = %v, want %v", user, want)
+	}
+	if info := ParseTokenInfo(resp); !info.HasScope("read:org") {
+		t.Errorf("Users.Get() scopes = %v, want read:org", info.Scopes)
+	}
+
+	// check other user
+	user, _, err = client.Users.Get(context.Background(), "hubot")
+	assertNilError(t, err)
+	if want := (&User{ID: Int64(2), Login: String("hubot")}); !cmp.Equal(user, want) {
+		t.Errorf("Users.Get() got = %v, want %v", user, want)
+	}
+}
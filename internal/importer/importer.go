@@ -0,0 +1,268 @@
+package importer
+
+import (
+	"cli-github-issues/internal/github"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Formats lists the supported input formats.
+var Formats = []string{"csv", "jsonl"}
+
+// Record is one row or object of the input, by field name.
+type Record map[string]any
+
+// String returns the value of field as text; list values are joined by ", ".
+func (r Record) String(field string) string {
+	switch v := r[field].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, Record{"": item}.String(""))
+		}
+		return strings.Join(values, ", ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Values returns the values of a multi-valued field: the items of a list, or
+// the text split at sep. Blank values are dropped.
+func (r Record) Values(field string, sep string) []string {
+	var values []string
+	if list, ok := r[field].([]any); ok {
+		for _, item := range list {
+			values = append(values, Record{"": item}.String(""))
+		}
+	} else {
+		values = strings.Split(r.String(field), sep)
+	}
+
+	out := values[:0]
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// FormatOf returns the input format of path by its extension, or "".
+func FormatOf(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return "csv"
+	case ".jsonl", ".ndjson":
+		return "jsonl"
+	}
+	return ""
+}
+
+// ReadRecords reads every record of r in format. CSV input has a header row
+// naming the fields; JSON Lines input has one object per line.
+func ReadRecords(r io.Reader, format string) ([]Record, error) {
+	const op = "importer.ReadRecords"
+
+	var records []Record
+	switch format {
+	case "csv":
+		rows, err := csv.NewReader(r).ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if len(rows) == 0 {
+			return nil, nil
+		}
+		// spreadsheet exports often start with a byte order mark
+		header := rows[0]
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+		for _, row := range rows[1:] {
+			record := make(Record, len(header))
+			for i, name := range header {
+				record[strings.TrimSpace(name)] = row[i]
+			}
+			records = append(records, record)
+		}
+	case "jsonl":
+		dec := json.NewDecoder(r)
+		dec.UseNumber()
+		for {
+			var record Record
+			err := dec.Decode(&record)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s: record %d: %w", op, len(records)+1, err)
+			}
+			records = append(records, record)
+		}
+	default:
+		return nil, fmt.Errorf("%s: unknown format %q, expected one of %v", op, format, Formats)
+	}
+	return records, nil
+}
+
+// Mapping names the input fields that become the fields of an issue.
+type Mapping struct {
+	// ID is the field holding the ID of a record in the source tracker,
+	// which the ledger is keyed by.
+	ID string `mapstructure:"id"`
+
+	Title string `mapstructure:"title"`
+	Body  string `mapstructure:"body"`
+
+	// Labels are the fields whose values all become labels.
+	Labels    []string `mapstructure:"labels"`
+	Assignees string   `mapstructure:"assignees"`
+
+	// Milestone is the field holding the milestone title.
+	Milestone string `mapstructure:"milestone"`
+
+	// State is the field holding the state, and ClosedStates its values
+	// that mean closed, such as "Done" or "Resolved".
+	State        string   `mapstructure:"state"`
+	ClosedStates []string `mapstructure:"closed_states"`
+
+	// Separator splits multi-valued text fields, "," by default.
+	Separator string `mapstructure:"separator"`
+
+	// Users maps source user names to GitHub logins, ignoring case. Names
+	// without an entry are used as they are.
+	Users map[string]string `mapstructure:"users"`
+
+	// ExtraFields are appended to the body as a table, to keep source
+	// details that have no issue field.
+	ExtraFields []string `mapstructure:"extra_fields"`
+}
+
+// LoadMapping reads a mapping file in any format viper supports, such as
+// YAML or JSON.
+func LoadMapping(path string) (*Mapping, error) {
+	const op = "importer.LoadMapping"
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	m := new(Mapping)
+	if err := v.Unmarshal(m); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if m.ID == "" || m.Title == "" {
+		return nil, fmt.Errorf("%s: id and title fields are required", op)
+	}
+	if m.Separator == "" {
+		m.Separator = ","
+	}
+	return m, nil
+}
+
+// Issue is a record mapped to the fields of an issue.
+type Issue struct {
+	SourceID  string
+	Title     string
+	Body      string
+	Labels    []string
+	Assignees []string
+	Milestone string
+	Closed    bool
+}
+
+// Issue maps a record to an issue. Records without ID or title are an error.
+func (m *Mapping) Issue(r Record) (*Issue, error) {
+	issue := &Issue{
+		SourceID:  strings.TrimSpace(r.String(m.ID)),
+		Title:     strings.TrimSpace(r.String(m.Title)),
+		Body:      r.String(m.Body),
+		Milestone: strings.TrimSpace(r.String(m.Milestone)),
+	}
+	if issue.SourceID == "" {
+		return nil, fmt.Errorf("empty %s field", m.ID)
+	}
+	if issue.Title == "" {
+		return nil, fmt.Errorf("%s: empty %s field", issue.SourceID, m.Title)
+	}
+
+	seen := map[string]bool{}
+	for _, field := range m.Labels {
+		for _, label := range r.Values(field, m.Separator) {
+			if key := strings.ToLower(label); !seen[key] {
+				seen[key] = true
+				issue.Labels = append(issue.Labels, label)
+			}
+		}
+	}
+	if m.Assignees != "" {
+		for _, name := range r.Values(m.Assignees, m.Separator) {
+			// viper lowercases the keys of maps
+			if login, ok := m.Users[strings.ToLower(name)]; ok {
+				name = login
+			}
+			issue.Assignees = append(issue.Assignees, name)
+		}
+	}
+	if m.State != "" {
+		state := strings.TrimSpace(r.String(m.State))
+		for _, closed := range m.ClosedStates {
+			if strings.EqualFold(state, closed) {
+				issue.Closed = true
+			}
+		}
+	}
+
+	var rows []string
+	for _, field := range m.ExtraFields {
+		if value := strings.TrimSpace(r.String(field)); value != "" {
+			rows = append(rows, fmt.Sprintf("| %s | %s |", escapeCell(field), escapeCell(value)))
+		}
+	}
+	if len(rows) > 0 {
+		if issue.Body = strings.TrimRight(issue.Body, "\n"); issue.Body != "" {
+			issue.Body += "\n\n"
+		}
+		issue.Body += "| Field | Value |\n| --- | --- |\n" + strings.Join(rows, "\n") + "\n"
+	}
+	return issue, nil
+}
+
+// Request returns the request creating the issue, with the number of its
+// milestone or 0 for none.
+func (i *Issue) Request(milestone int) *github.IssueRequest {
+	req := &github.IssueRequest{
+		Title: github.String(i.Title),
+		Body:  github.String(i.Body),
+	}
+	if len(i.Labels) > 0 {
+		req.Labels = &i.Labels
+	}
+	if len(i.Assignees) > 0 {
+		req.Assignees = &i.Assignees
+	}
+	if milestone != 0 {
+		req.Milestone = github.Int(milestone)
+	}
+	return req
+}
+
+func escapeCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "<br>"), "\n", "<br>")
+}
@@ -0,0 +1,167 @@
+package importer
+
+import (
+	"cli-github-issues/internal/github"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadRecords(t *testing.T) {
+	csvInput := "Key,Summary,Labels\nPROJ-1,\"Crash, on save\",\"bug, ui\"\n"
+	records, err := ReadRecords(strings.NewReader(csvInput), "csv")
+	assertNilError(t, err)
+	want := []Record{{"Key": "PROJ-1", "Summary": "Crash, on save", "Labels": "bug, ui"}}
+	if !cmp.Equal(records, want) {
+		t.Errorf("ReadRecords(csv) got = %v, want %v", records, want)
+	}
+
+	records, err = ReadRecords(strings.NewReader("\ufeff"+csvInput), "csv")
+	assertNilError(t, err)
+	if !cmp.Equal(records, want) {
+		t.Errorf("ReadRecords(csv) with byte order mark got = %v, want %v", records, want)
+	}
+
+	jsonInput := `{"key": 12345678901234567890, "labels": ["bug", "ui"], "done": true}` + "\n" + `{"key": "PROJ-2"}` + "\n"
+	records, err = ReadRecords(strings.NewReader(jsonInput), "jsonl")
+	assertNilError(t, err)
+	if len(records) != 2 {
+		t.Fatalf("ReadRecords(jsonl) got %d records, want 2", len(records))
+	}
+	if got, want := records[0].String("key"), "12345678901234567890"; got != want {
+		t.Errorf("Record.String() got = %v, want %v", got, want)
+	}
+	if got, want := records[0].String("done"), "true"; got != want {
+		t.Errorf("Record.String() got = %v, want %v", got, want)
+	}
+	if got, want := records[0].Values("labels", ","), []string{"bug", "ui"}; !cmp.Equal(got, want) {
+		t.Errorf("Record.Values() got = %v, want %v", got, want)
+	}
+
+	if _, err := ReadRecords(strings.NewReader(`{"key": `), "jsonl"); err == nil {
+		t.Error("ReadRecords() with truncated input error = nil, want error")
+	}
+}
+
+func TestLoadMapping(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mapping.yaml")
+	data := `id: Key
+title: Summary
+labels: [Labels, Priority]
+closed_states: [Done]
+users:
+  JDoe: johndoe
+`
+	assertNilError(t, os.WriteFile(path, []byte(data), 0o644))
+
+	m, err := LoadMapping(path)
+	assertNilError(t, err)
+	want := &Mapping{
+		ID:           "Key",
+		Title:        "Summary",
+		Labels:       []string{"Labels", "Priority"},
+		ClosedStates: []string{"Done"},
+		Separator:    ",",
+		Users:        map[string]string{"jdoe": "johndoe"},
+	}
+	if !cmp.Equal(m, want) {
+		t.Errorf("LoadMapping() got = %+v, want %+v", m, want)
+	}
+
+	assertNilError(t, os.WriteFile(path, []byte("title: Summary\n"), 0o644))
+	if _, err := LoadMapping(path); err == nil {
+		t.Error("LoadMapping() without id error = nil, want error")
+	}
+}
+
+func TestMapping_Issue(t *testing.T) {
+	m := &Mapping{
+		ID:           "Key",
+		Title:        "Summary",
+		Body:         "Description",
+		Labels:       []string{"Labels", "Priority"},
+		Assignees:    "Assignee",
+		Milestone:    "Fix Version",
+		State:        "Status",
+		ClosedStates: []string{"Done", "Resolved"},
+		Separator:    ",",
+		Users:        map[string]string{"jdoe": "johndoe"},
+		ExtraFields:  []string{"Reporter", "Sprint"},
+	}
+	record := Record{
+		"Key":         "PROJ-1",
+		"Summary":     " Crash on save ",
+		"Description": "It crashes.\n",
+		"Labels":      "bug, UI,",
+		"Priority":    "ui",
+		"Assignee":    "JDoe",
+		"Fix Version": "1.0",
+		"Status":      "resolved",
+		"Reporter":    "a|b",
+		"Sprint":      "",
+	}
+
+	issue, err := m.Issue(record)
+	assertNilError(t, err)
+	want := &Issue{
+		SourceID:  "PROJ-1",
+		Title:     "Crash on save",
+		Body:      "It crashes.\n\n| Field | Value |\n| --- | --- |\n| Reporter | a\\|b |\n",
+		Labels:    []string{"bug", "UI"},
+		Assignees: []string{"johndoe"},
+		Milestone: "1.0",
+		Closed:    true,
+	}
+	if diff := cmp.Diff(want, issue); diff != "" {
+		t.Errorf("Mapping.Issue() mismatch (-want +got):\n%s", diff)
+	}
+
+	wantReq := &github.IssueRequest{
+		Title:     github.String("Crash on save"),
+		Body:      github.String(want.Body),
+		Labels:    &[]string{"bug", "UI"},
+		Assignees: &[]string{"johndoe"},
+		Milestone: github.Int(7),
+	}
+	if req := issue.Request(7); !cmp.Equal(req, wantReq) {
+		t.Errorf("Issue.Request() got = %+v, want %+v", req, wantReq)
+	}
+
+	if _, err := m.Issue(Record{"Key": "PROJ-2"}); err == nil {
+		t.Error("Mapping.Issue() without title error = nil, want error")
+	}
+}
+
+func TestLedger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "import.ledger.json")
+
+	l, err := OpenLedger(path, "o/r")
+	assertNilError(t, err)
+	if _, ok := l.Number("PROJ-1"); ok {
+		t.Error("Ledger.Number() of empty ledger got ok = true, want false")
+	}
+	assertNilError(t, l.Add("PROJ-1", 42))
+
+	l, err = OpenLedger(path, "o/r")
+	assertNilError(t, err)
+	if number, ok := l.Number("PROJ-1"); !ok || number != 42 {
+		t.Errorf("Ledger.Number() got = %v, %v, want 42, true", number, ok)
+	}
+	if l.Len() != 1 {
+		t.Errorf("Ledger.Len() got = %v, want 1", l.Len())
+	}
+
+	if _, err := OpenLedger(path, "o/other"); err == nil {
+		t.Error("OpenLedger() of another repository error = nil, want error")
+	}
+}
+
+func assertNilError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
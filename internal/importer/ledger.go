@@ -0,0 +1,77 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// Ledger records the issue number each imported record became, so that an
+// import run again skips the records it already created.
+type Ledger struct {
+	path string
+	data ledgerData
+}
+
+type ledgerData struct {
+	Repo   string         `json:"repo"`
+	Issues map[string]int `json:"issues"`
+}
+
+// OpenLedger reads the ledger at path of an import into repo, or starts an
+// empty one if there is none. A ledger of another repository is an error.
+func OpenLedger(path string, repo string) (*Ledger, error) {
+	const op = "importer.OpenLedger"
+
+	l := &Ledger{path: path, data: ledgerData{Repo: repo, Issues: map[string]int{}}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := json.Unmarshal(data, &l.data); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if l.data.Repo != repo {
+		return nil, fmt.Errorf("%s: %s records an import into %s, not %s", op, path, l.data.Repo, repo)
+	}
+	if l.data.Issues == nil {
+		l.data.Issues = map[string]int{}
+	}
+	return l, nil
+}
+
+// Number returns the issue number the record with id became.
+func (l *Ledger) Number(id string) (int, bool) {
+	number, ok := l.data.Issues[id]
+	return number, ok
+}
+
+// Len returns the number of recorded issues.
+func (l *Ledger) Len() int {
+	return len(l.data.Issues)
+}
+
+// Add records that the record with id became issue number and saves the
+// ledger, replacing the file atomically.
+func (l *Ledger) Add(id string, number int) error {
+	const op = "importer.Ledger.Add"
+
+	l.data.Issues[id] = number
+	data, err := json.MarshalIndent(l.data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	tmp := l.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := os.Rename(tmp, l.path); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
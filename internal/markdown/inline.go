@@ -0,0 +1,146 @@
+package markdown
+
+import (
+	"cli-github-issues/internal/ansi"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// span is a run of text rendered with the same style.
+type span struct {
+	text  string
+	codes []ansi.Code
+}
+
+// parseInline splits s into styled spans for code, emphasis, links and images.
+func parseInline(s string, base ...ansi.Code) []span {
+	var (
+		spans []span
+		plain strings.Builder
+	)
+	style := func(extra ...ansi.Code) []ansi.Code {
+		return append(append([]ansi.Code{}, base...), extra...)
+	}
+	emit := func(sp ...span) {
+		if plain.Len() > 0 {
+			spans = append(spans, span{plain.String(), style()})
+			plain.Reset()
+		}
+		spans = append(spans, sp...)
+	}
+
+	for i := 0; i < len(s); {
+		rest := s[i:]
+		switch {
+		case rest[0] == '\\' && len(rest) > 1 && strings.ContainsRune("\\`*_[]()#+-.!<>~|", rune(rest[1])):
+			plain.WriteByte(rest[1])
+			i += 2
+			continue
+		case rest[0] == '`':
+			if end := strings.IndexByte(rest[1:], '`'); end >= 0 {
+				emit(span{rest[1 : end+1], style(ansi.Cyan)})
+				i += end + 2
+				continue
+			}
+		case strings.HasPrefix(rest, "**") || strings.HasPrefix(rest, "__"):
+			if end := strings.Index(rest[2:], rest[:2]); end > 0 {
+				emit(parseInline(rest[2:end+2], style(ansi.Bold)...)...)
+				i += end + 4
+				continue
+			}
+		case strings.HasPrefix(rest, "~~"):
+			if end := strings.Index(rest[2:], "~~"); end > 0 {
+				emit(parseInline(rest[2:end+2], style(ansi.Strike)...)...)
+				i += end + 4
+				continue
+			}
+		case (rest[0] == '*' || rest[0] == '_') && isOpeningDelimiter(s, i):
+			if end := strings.IndexByte(rest[1:], rest[0]); end > 0 {
+				emit(parseInline(rest[1:end+1], style(ansi.Italic)...)...)
+				i += end + 2
+				continue
+			}
+		case strings.HasPrefix(rest, "!["):
+			if text, url, n, ok := parseLink(rest[1:]); ok {
+				emit(span{"[image: " + text + "]", style(ansi.Dim)}, span{" " + url, style(ansi.Blue)})
+				i += n + 1
+				continue
+			}
+		case rest[0] == '[':
+			if text, url, n, ok := parseLink(rest); ok {
+				emit(parseInline(text, style(ansi.Underline)...)...)
+				if url != text {
+					emit(span{" (" + url + ")", style(ansi.Blue)})
+				}
+				i += n
+				continue
+			}
+		case rest[0] == '<' && (strings.HasPrefix(rest, "<http://") || strings.HasPrefix(rest, "<https://")):
+			if end := strings.IndexByte(rest, '>'); end > 0 {
+				emit(span{rest[1:end], style(ansi.Underline, ansi.Blue)})
+				i += end + 1
+				continue
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(rest)
+		plain.WriteString(rest[:size])
+		i += size
+	}
+	emit()
+
+	return spans
+}
+
+// parseLink parses "[text](url)" at the start of s and returns the number of
+// bytes consumed.
+func parseLink(s string) (text string, url string, n int, ok bool) {
+	closeText := strings.Index(s, "](")
+	if !strings.HasPrefix(s, "[") || closeText < 0 {
+		return "", "", 0, false
+	}
+	closeURL := strings.IndexByte(s[closeText:], ')')
+	if closeURL < 0 {
+		return "", "", 0, false
+	}
+	url, _, _ = strings.Cut(s[closeText+2:closeText+closeURL], " ")
+	return s[1:closeText], url, closeText + closeURL + 1, true
+}
+
+// isOpeningDelimiter reports whether the emphasis marker at s[i] can open
+// emphasis, so that snake_case words and "a * b" are left alone.
+func isOpeningDelimiter(s string, i int) bool {
+	if i+1 >= len(s) || s[i+1] == ' ' {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	prev, _ := utf8.DecodeLastRuneInString(s[:i])
+	return !unicode.IsLetter(prev) && !unicode.IsDigit(prev)
+}
+
+// splitWords groups spans into words separated by spaces. A word may consist
+// of several spans, e.g. "**bold**," is a bold span followed by a plain one.
+func splitWords(spans []span) [][]span {
+	var (
+		words   [][]span
+		current []span
+	)
+	for _, sp := range spans {
+		for j, part := range strings.Split(sp.text, " ") {
+			if j > 0 && len(current) > 0 {
+				words = append(words, current)
+				current = nil
+			}
+			if part != "" {
+				current = append(current, span{part, sp.codes})
+			}
+		}
+	}
+	if len(current) > 0 {
+		words = append(words, current)
+	}
+	return words
+}
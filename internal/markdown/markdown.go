@@ -0,0 +1,170 @@
+package markdown
+
+import (
+	"cli-github-issues/internal/ansi"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	headingRegexp    = regexp.MustCompile(`^(#{1,6})\s+(.*?)(\s+#+)?\s*$`)
+	ruleRegexp       = regexp.MustCompile(`^\s{0,3}(([-*_])\s*){3,}$`)
+	listItemRegexp   = regexp.MustCompile(`^(\s*)([-*+]|\d{1,9}[.)])\s+(.*)$`)
+	taskRegexp       = regexp.MustCompile(`^\[([ xX])\]\s+(.*)$`)
+	quoteRegexp      = regexp.MustCompile(`^\s{0,3}>\s?(.*)$`)
+	fenceRegexp      = regexp.MustCompile("^\\s{0,3}(```+|~~~+)\\s*(\\S*)")
+	htmlCommentRegex = regexp.MustCompile(`(?s)<!--.*?-->`)
+)
+
+type Renderer struct {
+	width   int
+	painter *ansi.Painter
+}
+
+// NewRenderer creates a new instance of the Renderer which wraps text at width
+// columns. A width of zero or less disables wrapping.
+func NewRenderer(width int, painter *ansi.Painter) *Renderer {
+	return &Renderer{width, painter}
+}
+
+// Render renders GitHub flavored Markdown src as terminal text.
+//
+// As on github.com, single line breaks in issue bodies are kept as is rather
+// than joined into paragraphs.
+func (r *Renderer) Render(src string) string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = htmlCommentRegex.ReplaceAllString(src, "")
+
+	var (
+		b          strings.Builder
+		fence      string
+		itemIndent string
+	)
+	for _, line := range strings.Split(src, "\n") {
+		// fenced code is printed verbatim
+		if fence != "" {
+			if strings.HasPrefix(strings.TrimSpace(line), fence) {
+				fence = ""
+				continue
+			}
+			b.WriteString("    " + r.painter.Paint(line, ansi.Cyan) + "\n")
+			continue
+		}
+		if m := fenceRegexp.FindStringSubmatch(line); m != nil {
+			fence, itemIndent = m[1], ""
+			continue
+		}
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			itemIndent = ""
+			b.WriteString("\n")
+		case headingRegexp.MatchString(line):
+			m := headingRegexp.FindStringSubmatch(line)
+			r.writeHeading(&b, len(m[1]), m[2])
+		case ruleRegexp.MatchString(line):
+			b.WriteString(r.painter.Paint(strings.Repeat("─", r.ruleWidth()), ansi.Gray) + "\n")
+		case quoteRegexp.MatchString(line):
+			text := quoteRegexp.FindStringSubmatch(line)[1]
+			bar := r.painter.Paint("│ ", ansi.Gray)
+			r.writeWrapped(&b, parseInline(text, ansi.Italic), bar, bar)
+		case listItemRegexp.MatchString(line):
+			itemIndent = r.writeListItem(&b, listItemRegexp.FindStringSubmatch(line))
+		case strings.HasPrefix(strings.TrimSpace(line), "|"):
+			// tables are kept as written
+			b.WriteString(line + "\n")
+		case itemIndent != "" && unicode.IsSpace(rune(line[0])):
+			// continuation of the previous list item
+			r.writeWrapped(&b, parseInline(strings.TrimSpace(line)), itemIndent, itemIndent)
+		default:
+			itemIndent = ""
+			r.writeWrapped(&b, parseInline(strings.TrimSpace(line)), "", "")
+		}
+	}
+
+	return strings.Trim(collapseBlankLines(b.String()), "\n") + "\n"
+}
+
+func (r *Renderer) writeHeading(b *strings.Builder, level int, text string) {
+	codes := []ansi.Code{ansi.Bold}
+	if level == 1 {
+		codes = append(codes, ansi.Underline)
+	}
+	marker := r.painter.Paint(strings.Repeat("#", level)+" ", ansi.Gray)
+	r.writeWrapped(b, parseInline(text, codes...), marker, strings.Repeat(" ", level+1))
+}
+
+// writeListItem writes a bullet, numbered or task list item and returns the
+// indentation for its continuation lines.
+func (r *Renderer) writeListItem(b *strings.Builder, m []string) string {
+	indent := "  " + strings.Repeat("  ", len(strings.ReplaceAll(m[1], "\t", "    "))/2)
+	marker, text := m[2], m[3]
+
+	switch {
+	case taskRegexp.MatchString(text):
+		t := taskRegexp.FindStringSubmatch(text)
+		text = t[2]
+		if t[1] == " " {
+			marker = "☐"
+		} else {
+			marker = r.painter.Paint("☑", ansi.Green)
+		}
+	case marker == "-" || marker == "*" || marker == "+":
+		marker = "•"
+	}
+
+	rest := indent + strings.Repeat(" ", visibleWidth(marker)+1)
+	r.writeWrapped(b, parseInline(text), indent+marker+" ", rest)
+	return rest
+}
+
+func (r *Renderer) ruleWidth() int {
+	if r.width <= 0 || r.width > 80 {
+		return 80
+	}
+	return r.width
+}
+
+// writeWrapped writes spans word-wrapped to the renderer width. first is
+// written before the first line and rest before every following one.
+func (r *Renderer) writeWrapped(b *strings.Builder, spans []span, first, rest string) {
+	prefix, lineLen := first, 0
+	b.WriteString(prefix)
+	for _, w := range splitWords(spans) {
+		wordLen := 0
+		for _, s := range w {
+			wordLen += utf8.RuneCountInString(s.text)
+		}
+
+		avail := r.width - visibleWidth(prefix)
+		if lineLen > 0 && r.width > 0 && lineLen+1+wordLen > avail {
+			prefix, lineLen = rest, 0
+			b.WriteString("\n" + prefix)
+		}
+		if lineLen > 0 {
+			b.WriteString(" ")
+			lineLen++
+		}
+		for _, s := range w {
+			b.WriteString(r.painter.Paint(s.text, s.codes...))
+		}
+		lineLen += wordLen
+	}
+	b.WriteString("\n")
+}
+
+// visibleWidth returns the number of columns s occupies, ignoring escape sequences.
+func visibleWidth(s string) int {
+	return utf8.RuneCountInString(ansiRegexp.ReplaceAllString(s, ""))
+}
+
+var ansiRegexp = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+func collapseBlankLines(s string) string {
+	for strings.Contains(s, "\n\n\n") {
+		s = strings.ReplaceAll(s, "\n\n\n", "\n\n")
+	}
+	return s
+}
@@ -0,0 +1,45 @@
+package markdown
+
+import (
+	"cli-github-issues/internal/ansi"
+	"testing"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	tests := []struct {
+		name  string
+		width int
+		src   string
+		want  string
+	}{
+		{
+			name: "Headings and inline styles",
+			src:  "## Steps\r\nRun `get` with **bold** and snake_case_name, see [docs](https://x.io).",
+			want: "## Steps\nRun get with bold and snake_case_name, see docs (https://x.io).\n",
+		},
+		{
+			name: "Task list",
+			src:  "- [ ] todo\n- [x] done\n  - nested\n1. first",
+			want: "  ☐ todo\n  ☑ done\n    • nested\n  1. first\n",
+		},
+		{
+			name:  "Wrapped list item",
+			width: 20,
+			src:   "- one two three four five six",
+			want:  "  • one two three\n    four five six\n",
+		},
+		{
+			name: "Code block and comments",
+			src:  "<!-- template -->\n```go\nfunc main() {}\n```\n\n\n\n> quote",
+			want: "    func main() {}\n\n│ quote\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewRenderer(tt.width, ansi.NewPainter(false)).Render(tt.src)
+			if got != tt.want {
+				t.Errorf("Render() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package mirror
+
+import (
+	"sort"
+	"strings"
+)
+
+// Conflict markers written by Merge3, in the style of diff3.
+const (
+	markerOurs   = "<<<<<<< ours"
+	markerBase   = "||||||| base"
+	markerSep    = "======="
+	markerTheirs = ">>>>>>> theirs"
+)
+
+// hunk replaces base[baseStart:baseEnd] with other[otherStart:otherEnd].
+type hunk struct {
+	baseStart, baseEnd   int
+	otherStart, otherEnd int
+	theirs               bool
+}
+
+// Merge3 merges the line based changes from base to ours and from base to
+// theirs. Changes to different lines are combined; changes to the same or
+// adjacent lines that differ are a conflict, written with diff3 style
+// markers, and reported as such.
+func Merge3(base string, ours string, theirs string) (merged string, conflict bool) {
+	baseLines, ourLines, theirLines := splitLines(base), splitLines(ours), splitLines(theirs)
+
+	hunks := diffHunks(baseLines, ourLines, false)
+	hunks = append(hunks, diffHunks(baseLines, theirLines, true)...)
+	sort.SliceStable(hunks, func(i, j int) bool { return hunks[i].baseStart < hunks[j].baseStart })
+
+	var out []string
+	pos := 0
+	for len(hunks) > 0 {
+		// group hunks touching the same or adjacent base lines
+		start, end := hunks[0].baseStart, hunks[0].baseEnd
+		n := 1
+		for ; n < len(hunks) && hunks[n].baseStart <= end; n++ {
+			end = max(end, hunks[n].baseEnd)
+		}
+		group := hunks[:n]
+		hunks = hunks[n:]
+
+		out = append(out, baseLines[pos:start]...)
+		pos = end
+
+		ourSide := sideLines(baseLines, ourLines, group, false, start, end)
+		theirSide := sideLines(baseLines, theirLines, group, true, start, end)
+		switch {
+		case !hasSide(group, true):
+			out = append(out, ourSide...)
+		case !hasSide(group, false) || equalLines(ourSide, theirSide):
+			out = append(out, theirSide...)
+		default:
+			conflict = true
+			out = append(out, markerOurs)
+			out = append(out, ourSide...)
+			out = append(out, markerBase)
+			out = append(out, baseLines[start:end]...)
+			out = append(out, markerSep)
+			out = append(out, theirSide...)
+			out = append(out, markerTheirs)
+		}
+	}
+	out = append(out, baseLines[pos:]...)
+
+	merged = strings.Join(out, "\n")
+	if strings.HasSuffix(ours, "\n") || strings.HasSuffix(theirs, "\n") {
+		merged += "\n"
+	}
+	return merged, conflict
+}
+
+// diffHunks returns the hunks turning base into other, from their longest
+// common subsequence of lines.
+func diffHunks(base []string, other []string, theirs bool) []hunk {
+	// lcs[i][j] is the LCS length of base[i:] and other[j:]
+	lcs := make([][]int, len(base)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(other)+1)
+	}
+	for i := len(base) - 1; i >= 0; i-- {
+		for j := len(other) - 1; j >= 0; j-- {
+			if base[i] == other[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var hunks []hunk
+	i, j := 0, 0
+	for i < len(base) || j < len(other) {
+		if i < len(base) && j < len(other) && base[i] == other[j] {
+			i++
+			j++
+			continue
+		}
+		h := hunk{baseStart: i, otherStart: j, theirs: theirs}
+		for (i < len(base) || j < len(other)) && !(i < len(base) && j < len(other) && base[i] == other[j]) {
+			if j == len(other) || (i < len(base) && lcs[i+1][j] >= lcs[i][j+1]) {
+				i++
+			} else {
+				j++
+			}
+		}
+		h.baseEnd, h.otherEnd = i, j
+		hunks = append(hunks, h)
+	}
+	return hunks
+}
+
+// sideLines returns the lines one side has in place of base[start:end],
+// applying its hunks of group.
+func sideLines(base []string, side []string, group []hunk, theirs bool, start int, end int) []string {
+	var out []string
+	pos := start
+	for _, h := range group {
+		if h.theirs != theirs {
+			continue
+		}
+		out = append(out, base[pos:h.baseStart]...)
+		out = append(out, side[h.otherStart:h.otherEnd]...)
+		pos = h.baseEnd
+	}
+	return append(out, base[pos:end]...)
+}
+
+func hasSide(group []hunk, theirs bool) bool {
+	for _, h := range group {
+		if h.theirs == theirs {
+			return true
+		}
+	}
+	return false
+}
+
+func equalLines(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimSuffix(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
@@ -0,0 +1,55 @@
+package mirror
+
+import "testing"
+
+func TestMerge3(t *testing.T) {
+	base := "intro\nsteps\n- one\n- two\noutro\n"
+
+	tests := []struct {
+		name         string
+		ours         string
+		theirs       string
+		want         string
+		wantConflict bool
+	}{
+		{
+			name:   "Only ours changed",
+			ours:   "intro\nsteps\n- one\n- two\n- three\noutro\n",
+			theirs: base,
+			want:   "intro\nsteps\n- one\n- two\n- three\noutro\n",
+		},
+		{
+			name:   "Only theirs changed",
+			ours:   base,
+			theirs: "intro\nsteps\n- one\n- 2\noutro\n",
+			want:   "intro\nsteps\n- one\n- 2\noutro\n",
+		},
+		{
+			name:   "Different lines changed",
+			ours:   "Intro!\nsteps\n- one\n- two\noutro\n",
+			theirs: "intro\nsteps\n- one\n- two\nOutro!\n",
+			want:   "Intro!\nsteps\n- one\n- two\nOutro!\n",
+		},
+		{
+			name:   "Same change on both sides",
+			ours:   "intro\nsteps\n- 1\n- two\noutro\n",
+			theirs: "intro\nsteps\n- 1\n- two\noutro\n",
+			want:   "intro\nsteps\n- 1\n- two\noutro\n",
+		},
+		{
+			name:         "Same line changed differently",
+			ours:         "intro\nsteps\n- uno\n- two\noutro\n",
+			theirs:       "intro\nsteps\n- eins\n- two\noutro\n",
+			want:         "intro\nsteps\n<<<<<<< ours\n- uno\n||||||| base\n- one\n=======\n- eins\n>>>>>>> theirs\n- two\noutro\n",
+			wantConflict: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflict := Merge3(base, tt.ours, tt.theirs)
+			if got != tt.want || conflict != tt.wantConflict {
+				t.Errorf("Merge3() got = %q, %v, want %q, %v", got, conflict, tt.want, tt.wantConflict)
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package mirror
+
+import (
+	"bytes"
+	"cli-github-issues/internal/github"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	bucketIssues   = []byte("issues")
+	bucketComments = []byte("comments")
+	bucketLabels   = []byte("labels")
+	bucketMeta     = []byte("meta")
+
+	keySyncState = []byte("sync")
+)
+
+// ErrNotFound is returned when the mirror has no such issue.
+var ErrNotFound = errors.New("not found in mirror")
+
+// Mirror is a local copy of the issues, comments and labels of repositories,
+// kept in a bbolt database with one top-level bucket per repository.
+type Mirror struct {
+	db *bolt.DB
+}
+
+// SyncState records how far a repository has been synced.
+type SyncState struct {
+	// LastSync is when the repository was last synced.
+	LastSync time.Time `json:"last_sync"`
+
+	// Since is the since parameter of the next incremental sync, shortly
+	// before the start of the last one.
+	Since time.Time `json:"since"`
+}
+
+// DefaultPath returns the path of the mirror database in the user cache
+// directory.
+func DefaultPath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "cli-github-issues", "mirror.db"), nil
+}
+
+// Open opens or creates the mirror database at path.
+func Open(path string) (*Mirror, error) {
+	const op = "mirror.Open"
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &Mirror{db}, nil
+}
+
+// Close closes the mirror database.
+func (m *Mirror) Close() error {
+	return m.db.Close()
+}
+
+// PutIssues stores issues of repo, replacing earlier copies.
+func (m *Mirror) PutIssues(repo string, issues []*github.Issue) error {
+	return m.update(repo, func(b *bolt.Bucket) error {
+		for _, issue := range issues {
+			if err := putJSON(b.Bucket(bucketIssues), itob(issue.GetNumber()), issue); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Issue returns the stored issue of repo with number.
+func (m *Mirror) Issue(repo string, number int) (*github.Issue, error) {
+	var issue *github.Issue
+	err := m.view(repo, func(b *bolt.Bucket) error {
+		return getJSON(b.Bucket(bucketIssues), itob(number), &issue)
+	})
+	return issue, err
+}
+
+// Issues returns every stored issue of repo, ordered by number.
+func (m *Mirror) Issues(repo string) ([]*github.Issue, error) {
+	var issues []*github.Issue
+	err := m.view(repo, func(b *bolt.Bucket) error {
+		return b.Bucket(bucketIssues).ForEach(func(k, v []byte) error {
+			issue := new(github.Issue)
+			if err := json.Unmarshal(v, issue); err != nil {
+				return err
+			}
+			issues = append(issues, issue)
+			return nil
+		})
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return issues, err
+}
+
+// PutComments merges comments of repo into the stored comment threads,
+// replacing earlier copies of the same comment.
+func (m *Mirror) PutComments(repo string, comments []*github.IssueComment) error {
+	byIssue := make(map[int][]*github.IssueComment)
+	for _, c := range comments {
+		byIssue[c.IssueNumber()] = append(byIssue[c.IssueNumber()], c)
+	}
+
+	return m.update(repo, func(b *bolt.Bucket) error {
+		for number, updated := range byIssue {
+			var thread []*github.IssueComment
+			key := itob(number)
+			if err := getJSON(b.Bucket(bucketComments), key, &thread); err != nil && !errors.Is(err, ErrNotFound) {
+				return err
+			}
+			thread = mergeComments(thread, updated)
+			if err := putJSON(b.Bucket(bucketComments), key, thread); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Comments returns the stored comment thread of an issue of repo.
+func (m *Mirror) Comments(repo string, number int) ([]*github.IssueComment, error) {
+	var thread []*github.IssueComment
+	err := m.view(repo, func(b *bolt.Bucket) error {
+		return getJSON(b.Bucket(bucketComments), itob(number), &thread)
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return thread, err
+}
+
+// PutLabels replaces the stored labels of repo.
+func (m *Mirror) PutLabels(repo string, labels []*github.Label) error {
+	return m.update(repo, func(b *bolt.Bucket) error {
+		if err := b.DeleteBucket(bucketLabels); err != nil {
+			return err
+		}
+		lb, err := b.CreateBucket(bucketLabels)
+		if err != nil {
+			return err
+		}
+		for _, label := range labels {
+			if err := putJSON(lb, []byte(label.GetName()), label); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Labels returns the stored labels of repo, ordered by name.
+func (m *Mirror) Labels(repo string) ([]*github.Label, error) {
+	var labels []*github.Label
+	err := m.view(repo, func(b *bolt.Bucket) error {
+		return b.Bucket(bucketLabels).ForEach(func(k, v []byte) error {
+			label := new(github.Label)
+			if err := json.Unmarshal(v, label); err != nil {
+				return err
+			}
+			labels = append(labels, label)
+			return nil
+		})
+	})
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return labels, err
+}
+
+// SyncState returns the sync state of repo, which is zero if the repository
+// was never synced.
+func (m *Mirror) SyncState(repo string) (SyncState, error) {
+	var state SyncState
+	err := m.view(repo, func(b *bolt.Bucket) error {
+		return getJSON(b.Bucket(bucketMeta), keySyncState, &state)
+	})
+	if errors.Is(err, ErrNotFound) {
+		return SyncState{}, nil
+	}
+	return state, err
+}
+
+// SetSyncState stores the sync state of repo.
+func (m *Mirror) SetSyncState(repo string, state SyncState) error {
+	return m.update(repo, func(b *bolt.Bucket) error {
+		return putJSON(b.Bucket(bucketMeta), keySyncState, state)
+	})
+}
+
+// Repos returns the names of the mirrored repositories.
+func (m *Mirror) Repos() ([]string, error) {
+	var repos []string
+	err := m.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			if bytes.ContainsRune(name, '/') {
+				repos = append(repos, string(name))
+			}
+			return nil
+		})
+	})
+	return repos, err
+}
+
+// update runs fn in a writable transaction on the bucket of repo, creating
+// the bucket and its sub-buckets when missing.
+func (m *Mirror) update(repo string, fn func(b *bolt.Bucket) error) error {
+	return m.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(repo))
+		if err != nil {
+			return err
+		}
+		for _, name := range [][]byte{bucketIssues, bucketComments, bucketLabels, bucketMeta} {
+			if _, err := b.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return fn(b)
+	})
+}
+
+// view runs fn in a read-only transaction on the bucket of repo. It returns
+// ErrNotFound if the repository was never mirrored.
+func (m *Mirror) view(repo string, fn func(b *bolt.Bucket) error) error {
+	return m.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(repo))
+		if b == nil {
+			return ErrNotFound
+		}
+		return fn(b)
+	})
+}
+
+func putJSON(b *bolt.Bucket, key []byte, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return b.Put(key, data)
+}
+
+func getJSON(b *bolt.Bucket, key []byte, v any) error {
+	data := b.Get(key)
+	if data == nil {
+		return ErrNotFound
+	}
+	return json.Unmarshal(data, v)
+}
+
+// itob encodes an issue number as a big endian key so that keys sort by number.
+func itob(n int) []byte {
+	return u64tob(uint64(n))
+}
+
+// mergeComments replaces comments of thread with their updated copies, adds
+// new ones and keeps the thread ordered by creation time.
+func mergeComments(thread []*github.IssueComment, updated []*github.IssueComment) []*github.IssueComment {
+	index := make(map[int64]int, len(thread))
+	for i, c := range thread {
+		index[c.GetID()] = i
+	}
+	for _, c := range updated {
+		if i, ok := index[c.GetID()]; ok {
+			thread[i] = c
+		} else {
+			index[c.GetID()] = len(thread)
+			thread = append(thread, c)
+		}
+	}
+	sort.SliceStable(thread, func(i, j int) bool {
+		return thread[i].GetCreatedAt().Before(thread[j].GetCreatedAt())
+	})
+	return thread
+}
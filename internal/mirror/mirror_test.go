@@ -0,0 +1,182 @@
+package mirror
+
+import (
+	"cli-github-issues/internal/github"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testRepo = "testOwner/testRepo"
+
+func setupMirror(t *testing.T) *Mirror {
+	t.Helper()
+	m, err := Open(filepath.Join(t.TempDir(), "mirror.db"))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	t.Cleanup(func() { m.Close() })
+	return m
+}
+
+func TestMirror_Issues(t *testing.T) {
+	m := setupMirror(t)
+
+	if _, err := m.Issue(testRepo, 1); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Issue() error = %v, want %v", err, ErrNotFound)
+	}
+
+	issues := []*github.Issue{
+		{Number: github.Int(10), Title: github.String("ten")},
+		{Number: github.Int(2), Title: github.String("two")},
+	}
+	if err := m.PutIssues(testRepo, issues); err != nil {
+		t.Fatalf("PutIssues() error = %v", err)
+	}
+	if err := m.PutIssues(testRepo, []*github.Issue{{Number: github.Int(2), Title: github.String("two, renamed")}}); err != nil {
+		t.Fatalf("PutIssues() error = %v", err)
+	}
+
+	got, err := m.Issues(testRepo)
+	want := []*github.Issue{
+		{Number: github.Int(2), Title: github.String("two, renamed")},
+		{Number: github.Int(10), Title: github.String("ten")},
+	}
+	if err != nil || !cmp.Equal(got, want) {
+		t.Errorf("Issues() got = %v, want %v, error = %v", got, want, err)
+	}
+}
+
+func TestMirror_Comments(t *testing.T) {
+	m := setupMirror(t)
+
+	at := func(h int) *time.Time {
+		v := time.Date(2026, 1, 1, h, 0, 0, 0, time.UTC)
+		return &v
+	}
+	issueURL := github.String("https://api.github.com/repos/testOwner/testRepo/issues/3")
+	first := []*github.IssueComment{
+		{ID: github.Int64(2), Body: github.String("second"), IssueURL: issueURL, CreatedAt: at(2)},
+		{ID: github.Int64(1), Body: github.String("first"), IssueURL: issueURL, CreatedAt: at(1)},
+	}
+	updated := []*github.IssueComment{
+		{ID: github.Int64(1), Body: github.String("first, edited"), IssueURL: issueURL, CreatedAt: at(1)},
+	}
+	for _, comments := range [][]*github.IssueComment{first, updated} {
+		if err := m.PutComments(testRepo, comments); err != nil {
+			t.Fatalf("PutComments() error = %v", err)
+		}
+	}
+
+	got, err := m.Comments(testRepo, 3)
+	want := []*github.IssueComment{updated[0], first[0]}
+	if err != nil || !cmp.Equal(got, want) {
+		t.Errorf("Comments() got = %v, want %v, error = %v", got, want, err)
+	}
+}
+
+func TestMirror_SyncState(t *testing.T) {
+	m := setupMirror(t)
+
+	if state, err := m.SyncState(testRepo); err != nil || !state.LastSync.IsZero() {
+		t.Errorf("SyncState() got = %v, want zero, error = %v", state, err)
+	}
+
+	want := SyncState{LastSync: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), Since: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if err := m.SetSyncState(testRepo, want); err != nil {
+		t.Fatalf("SetSyncState() error = %v", err)
+	}
+	if got, err := m.SyncState(testRepo); err != nil || !got.LastSync.Equal(want.LastSync) || !got.Since.Equal(want.Since) {
+		t.Errorf("SyncState() got = %v, want %v, error = %v", got, want, err)
+	}
+	if repos, _ := m.Repos(); !cmp.Equal(repos, []string{testRepo}) {
+		t.Errorf("Repos() got = %v, want %v", repos, []string{testRepo})
+	}
+}
+
+func TestMirror_Queue(t *testing.T) {
+	m := setupMirror(t)
+
+	changes := []*Change{
+		{Kind: ChangeComment, Repo: testRepo, Number: 1, Comment: "first"},
+		{Kind: ChangeAddLabels, Repo: testRepo, Number: 1, Labels: []string{"bug"}},
+	}
+	for _, c := range changes {
+		if err := m.Enqueue(c); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+	if err := m.Dequeue(changes[0].ID); err != nil {
+		t.Fatalf("Dequeue() error = %v", err)
+	}
+	if err := m.Dequeue(changes[0].ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Dequeue() error = %v, want %v", err, ErrNotFound)
+	}
+
+	got, err := m.Queue()
+	if err != nil || !cmp.Equal(got, changes[1:]) {
+		t.Errorf("Queue() got = %v, want %v, error = %v", got, changes[1:], err)
+	}
+
+	// the queue is not a repository
+	if repos, _ := m.Repos(); len(repos) != 0 {
+		t.Errorf("Repos() got = %v, want none", repos)
+	}
+}
+
+func TestChange_Edits(t *testing.T) {
+	base := &github.Issue{Title: github.String("Crash"), Body: github.String("It crashes.")}
+
+	// a title left as it was is not sent, so a rename on GitHub is kept
+	c := &Change{
+		Kind:    ChangeUpdate,
+		Request: &github.IssueRequest{Title: github.String("Crash"), Body: github.String("It crashes on save.")},
+		Base:    base,
+	}
+	want := &github.IssueRequest{Body: github.String("It crashes on save.")}
+	if got := c.Edits(); !cmp.Equal(got, want) {
+		t.Errorf("Change.Edits() got = %+v, want %+v", got, want)
+	}
+	if c.Request.Title == nil {
+		t.Error("Change.Edits() modified the request of the change")
+	}
+
+	// a body left as it was is not sent either
+	c.Request = &github.IssueRequest{Title: github.String("Crash on save"), Body: github.String("It crashes.")}
+	want = &github.IssueRequest{Title: github.String("Crash on save")}
+	if got := c.Edits(); !cmp.Equal(got, want) {
+		t.Errorf("Change.Edits() got = %+v, want %+v", got, want)
+	}
+}
+
+func TestChange_Apply(t *testing.T) {
+	base := &github.Issue{Number: github.Int(7), Title: github.String("Crash"), Body: github.String("It crashes."), State: github.String("open")}
+
+	c := &Change{Kind: ChangeUpdate, Request: &github.IssueRequest{Body: github.String("It crashes on save.")}}
+	want := &github.Issue{Number: github.Int(7), Title: github.String("Crash"), Body: github.String("It crashes on save."), State: github.String("open")}
+	if got := c.Apply(base); !cmp.Equal(got, want) {
+		t.Errorf("Change.Apply() got = %+v, want %+v", got, want)
+	}
+	if base.GetBody() != "It crashes." {
+		t.Error("Change.Apply() modified the issue")
+	}
+
+	// a second change is based on the issue as the first one leaves it
+	next := &Change{Kind: ChangeUpdate, Request: &github.IssueRequest{Body: github.String("It crashes on save and on exit.")}, Base: c.Apply(base)}
+	wantEdits := &github.IssueRequest{Body: github.String("It crashes on save and on exit.")}
+	if got := next.Edits(); !cmp.Equal(got, wantEdits) {
+		t.Errorf("Change.Edits() got = %+v, want %+v", got, wantEdits)
+	}
+	if next.Base.GetBody() != "It crashes on save." {
+		t.Errorf("Change.Apply() body = %q, want %q", next.Base.GetBody(), "It crashes on save.")
+	}
+
+	// a comment leaves the issue as it is
+	comment := &Change{Kind: ChangeComment, Comment: "Same here."}
+	if got := comment.Apply(base); got != base {
+		t.Errorf("Change.Apply() got = %+v, want %+v", got, base)
+	}
+}
@@ -0,0 +1,283 @@
+package mirror
+
+import (
+	"cli-github-issues/internal/github"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// Query is the subset of the GitHub issue search syntax that can be evaluated
+// against mirrored issues: free text, and the is, state, label, author,
+// assignee, milestone, no, in, created, updated, closed, repo, org and user
+// qualifiers, each optionally negated with a leading "-".
+type Query struct {
+	// Repos are the repositories named with repo qualifiers.
+	Repos []string
+
+	// Owners are the organizations and users named with org and user qualifiers.
+	Owners []string
+
+	text    []string
+	in      []string
+	filters []func(issue *github.Issue) bool
+}
+
+// ParseQuery parses a search query. Qualifiers the mirror cannot evaluate
+// result in an error rather than being ignored.
+func ParseQuery(query string) (*Query, error) {
+	const op = "mirror.ParseQuery"
+
+	q := &Query{}
+	for _, token := range splitQuery(query) {
+		key, value, ok := strings.Cut(token, ":")
+		if !ok || value == "" || strings.ContainsAny(key, ` "`) {
+			q.text = append(q.text, strings.ToLower(strings.Trim(token, `"`)))
+			continue
+		}
+		negate := strings.HasPrefix(key, "-")
+		key = strings.TrimPrefix(key, "-")
+		value = strings.Trim(value, `"`)
+
+		f, err := q.qualifier(key, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if f == nil {
+			continue
+		}
+		if negate {
+			f = not(f)
+		}
+		q.filters = append(q.filters, f)
+	}
+	return q, nil
+}
+
+// Match reports whether issue matches the query, ignoring its repository.
+func (q *Query) Match(issue *github.Issue) bool {
+	for _, f := range q.filters {
+		if !f(issue) {
+			return false
+		}
+	}
+
+	in := q.in
+	if len(in) == 0 {
+		in = []string{"title", "body"}
+	}
+	var haystack strings.Builder
+	for _, field := range in {
+		switch field {
+		case "title":
+			haystack.WriteString(strings.ToLower(issue.GetTitle()) + "\n")
+		case "body":
+			haystack.WriteString(strings.ToLower(issue.GetBody()) + "\n")
+		}
+	}
+	for _, term := range q.text {
+		if !strings.Contains(haystack.String(), term) {
+			return false
+		}
+	}
+	return true
+}
+
+// InScope reports whether the repository "owner/name" is selected by the
+// repo, org and user qualifiers of the query. Without such qualifiers every
+// repository is.
+func (q *Query) InScope(repo string) bool {
+	if len(q.Repos) == 0 && len(q.Owners) == 0 {
+		return true
+	}
+	owner, _, _ := strings.Cut(repo, "/")
+	for _, r := range q.Repos {
+		if strings.EqualFold(r, repo) {
+			return true
+		}
+	}
+	for _, o := range q.Owners {
+		if strings.EqualFold(o, owner) {
+			return true
+		}
+	}
+	return false
+}
+
+// qualifier returns the filter of a qualifier, or nil for qualifiers that
+// only change the scope.
+func (q *Query) qualifier(key string, value string) (func(issue *github.Issue) bool, error) {
+	switch key {
+	case "repo":
+		q.Repos = append(q.Repos, value)
+		return nil, nil
+	case "org", "user":
+		q.Owners = append(q.Owners, value)
+		return nil, nil
+	case "in":
+		q.in = append(q.in, strings.Split(value, ",")...)
+		return nil, nil
+	case "is":
+		switch value {
+		case "open", "closed":
+			return stateIs(value), nil
+		case "issue":
+			return func(issue *github.Issue) bool { return !issue.IsPullRequest() }, nil
+		case "pr", "pull-request":
+			return func(issue *github.Issue) bool { return issue.IsPullRequest() }, nil
+		case "locked":
+			return func(issue *github.Issue) bool { return issue.GetLocked() }, nil
+		case "unlocked":
+			return func(issue *github.Issue) bool { return !issue.GetLocked() }, nil
+		}
+	case "state":
+		return stateIs(value), nil
+	case "label":
+		return func(issue *github.Issue) bool {
+			for _, label := range issue.Labels {
+				if strings.EqualFold(label.GetName(), value) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case "author":
+		return func(issue *github.Issue) bool { return strings.EqualFold(issue.GetUser().GetLogin(), value) }, nil
+	case "assignee":
+		return func(issue *github.Issue) bool {
+			for _, user := range issue.Assignees {
+				if strings.EqualFold(user.GetLogin(), value) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case "milestone":
+		return func(issue *github.Issue) bool {
+			m := issue.GetMilestone()
+			return m != nil && (strings.EqualFold(m.GetTitle(), value) || strconv.Itoa(m.GetNumber()) == value)
+		}, nil
+	case "no":
+		switch value {
+		case "label":
+			return func(issue *github.Issue) bool { return len(issue.Labels) == 0 }, nil
+		case "assignee":
+			return func(issue *github.Issue) bool { return len(issue.Assignees) == 0 }, nil
+		case "milestone":
+			return func(issue *github.Issue) bool { return issue.GetMilestone() == nil }, nil
+		}
+	case "created", "updated", "closed":
+		match, err := parseDateRange(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%s: %w", key, value, err)
+		}
+		return func(issue *github.Issue) bool {
+			var t time.Time
+			switch key {
+			case "created":
+				t = issue.GetCreatedAt()
+			case "updated":
+				t = issue.GetUpdatedAt()
+			case "closed":
+				t = issue.GetClosedAt()
+			}
+			return !t.IsZero() && match(t)
+		}, nil
+	}
+	return nil, fmt.Errorf("qualifier %s:%s is not supported offline", key, value)
+}
+
+func stateIs(state string) func(issue *github.Issue) bool {
+	return func(issue *github.Issue) bool { return issue.GetState() == state }
+}
+
+func not(f func(issue *github.Issue) bool) func(issue *github.Issue) bool {
+	return func(issue *github.Issue) bool { return !f(issue) }
+}
+
+// parseDateRange parses a date qualifier value such as "2026-01-01",
+// ">=2026-01-01", "<2026-01-01" or "2026-01-01..2026-02-01", with dates
+// compared by UTC day.
+func parseDateRange(value string) (func(t time.Time) bool, error) {
+	parse := func(s string) (time.Time, error) {
+		if s == "*" {
+			return time.Time{}, nil
+		}
+		return time.Parse(dateLayout, s)
+	}
+	day := func(t time.Time) time.Time {
+		return t.UTC().Truncate(24 * time.Hour)
+	}
+
+	if from, to, ok := strings.Cut(value, ".."); ok {
+		start, err := parse(from)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parse(to)
+		if err != nil {
+			return nil, err
+		}
+		return func(t time.Time) bool {
+			d := day(t)
+			return (start.IsZero() || !d.Before(start)) && (end.IsZero() || !d.After(end))
+		}, nil
+	}
+
+	for _, op := range []string{">=", "<=", ">", "<", ""} {
+		rest, ok := strings.CutPrefix(value, op)
+		if !ok {
+			continue
+		}
+		date, err := parse(rest)
+		if err != nil {
+			return nil, err
+		}
+		return func(t time.Time) bool {
+			d := day(t)
+			switch op {
+			case ">=":
+				return !d.Before(date)
+			case "<=":
+				return !d.After(date)
+			case ">":
+				return d.After(date)
+			case "<":
+				return d.Before(date)
+			default:
+				return d.Equal(date)
+			}
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid date range %q", value)
+}
+
+// splitQuery splits a query at whitespace outside double quotes.
+func splitQuery(query string) []string {
+	var (
+		tokens []string
+		token  strings.Builder
+		quoted bool
+	)
+	for _, r := range query {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			token.WriteRune(r)
+		case (r == ' ' || r == '\t' || r == '\n') && !quoted:
+			if token.Len() > 0 {
+				tokens = append(tokens, token.String())
+				token.Reset()
+			}
+		default:
+			token.WriteRune(r)
+		}
+	}
+	if token.Len() > 0 {
+		tokens = append(tokens, token.String())
+	}
+	return tokens
+}
@@ -0,0 +1,69 @@
+package mirror
+
+import (
+	"cli-github-issues/internal/github"
+	"testing"
+	"time"
+)
+
+func TestQuery_Match(t *testing.T) {
+	created := time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC)
+	issue := &github.Issue{
+		Title:     github.String("Crash on startup"),
+		Body:      github.String("The app panics when the config is missing."),
+		State:     github.String("open"),
+		User:      &github.User{Login: github.String("octocat")},
+		Labels:    []*github.Label{{Name: github.String("good first issue")}},
+		CreatedAt: &created,
+	}
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"crash", true},
+		{"crash in:body", false},
+		{`"config is missing"`, true},
+		{"is:open is:issue", true},
+		{"is:closed", false},
+		{"-is:closed", true},
+		{`label:"good first issue" author:octocat`, true},
+		{"-label:bug", true},
+		{"no:assignee no:milestone", true},
+		{"assignee:octocat", false},
+		{"created:>=2026-03-01", true},
+		{"created:2026-03-15", true},
+		{"created:2026-03-16..*", false},
+		{"updated:>2026-01-01", false},
+		{"repo:testOwner/testRepo crash", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			q, err := ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseQuery() error = %v", err)
+			}
+			if got := q.Match(issue); got != tt.want {
+				t.Errorf("Query.Match() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery_InScope(t *testing.T) {
+	q, err := ParseQuery("repo:a/one org:b crash")
+	if err != nil {
+		t.Fatalf("ParseQuery() error = %v", err)
+	}
+	for repo, want := range map[string]bool{"a/one": true, "a/two": false, "b/three": true} {
+		if got := q.InScope(repo); got != want {
+			t.Errorf("Query.InScope(%q) got = %v, want %v", repo, got, want)
+		}
+	}
+}
+
+func TestParseQuery_Unsupported(t *testing.T) {
+	if _, err := ParseQuery("reactions:>10"); err == nil {
+		t.Errorf("ParseQuery() expected error for an unsupported qualifier")
+	}
+}
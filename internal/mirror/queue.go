@@ -0,0 +1,138 @@
+package mirror
+
+import (
+	"cli-github-issues/internal/github"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// bucketQueue holds queued changes. Its name has no slash, so it cannot
+// clash with the bucket of a repository.
+var bucketQueue = []byte("queue")
+
+// ChangeKind is the kind of a queued change.
+type ChangeKind string
+
+const (
+	ChangeCreate       ChangeKind = "create"
+	ChangeUpdate       ChangeKind = "update"
+	ChangeClose        ChangeKind = "close"
+	ChangeComment      ChangeKind = "comment"
+	ChangeAddLabels    ChangeKind = "add_labels"
+	ChangeRemoveLabels ChangeKind = "remove_labels"
+)
+
+// Change is an edit made offline, replayed against GitHub by sync push.
+type Change struct {
+	ID       uint64     `json:"id"`
+	Kind     ChangeKind `json:"kind"`
+	Repo     string     `json:"repo"`
+	QueuedAt time.Time  `json:"queued_at"`
+
+	// Number is the number of the changed issue, zero for ChangeCreate.
+	Number int `json:"number,omitempty"`
+
+	// Request holds the fields of ChangeCreate, ChangeUpdate and ChangeClose.
+	Request *github.IssueRequest `json:"request,omitempty"`
+
+	// Comment is the body of ChangeComment.
+	Comment string `json:"comment,omitempty"`
+
+	// Labels are the names of ChangeAddLabels and ChangeRemoveLabels.
+	Labels []string `json:"labels,omitempty"`
+
+	// Base is the mirrored issue when the change was queued, used to detect
+	// changes made on GitHub in the meantime.
+	Base *github.Issue `json:"base,omitempty"`
+}
+
+// Edits returns the request of c without the title and body if they equal
+// those of Base, so that replaying c keeps edits made on GitHub to fields c
+// did not change.
+func (c *Change) Edits() *github.IssueRequest {
+	if c.Request == nil || c.Base == nil {
+		return c.Request
+	}
+	req := *c.Request
+	if req.Title != nil && *req.Title == c.Base.GetTitle() {
+		req.Title = nil
+	}
+	if req.Body != nil && *req.Body == c.Base.GetBody() {
+		req.Body = nil
+	}
+	return &req
+}
+
+// Apply returns a copy of issue with the title, body and state of the
+// request of c, the issue as it stands once c is replayed.
+func (c *Change) Apply(issue *github.Issue) *github.Issue {
+	if c.Request == nil || issue == nil {
+		return issue
+	}
+	applied := *issue
+	if c.Request.Title != nil {
+		applied.Title = c.Request.Title
+	}
+	if c.Request.Body != nil {
+		applied.Body = c.Request.Body
+	}
+	if c.Request.State != nil {
+		applied.State = c.Request.State
+	}
+	return &applied
+}
+
+// Enqueue appends c to the queue, assigning its ID.
+func (m *Mirror) Enqueue(c *Change) error {
+	return m.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(bucketQueue)
+		if err != nil {
+			return err
+		}
+		if c.ID, err = b.NextSequence(); err != nil {
+			return err
+		}
+		return putJSON(b, u64tob(c.ID), c)
+	})
+}
+
+// Queue returns the queued changes in the order they were queued.
+func (m *Mirror) Queue() ([]*Change, error) {
+	var changes []*Change
+	err := m.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketQueue)
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			c := new(Change)
+			if err := json.Unmarshal(v, c); err != nil {
+				return err
+			}
+			changes = append(changes, c)
+			return nil
+		})
+	})
+	return changes, err
+}
+
+// Dequeue removes the change with id from the queue. It returns ErrNotFound
+// if there is no such change.
+func (m *Mirror) Dequeue(id uint64) error {
+	return m.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketQueue)
+		if b == nil || b.Get(u64tob(id)) == nil {
+			return ErrNotFound
+		}
+		return b.Delete(u64tob(id))
+	})
+}
+
+func u64tob(n uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, n)
+	return b
+}
@@ -0,0 +1,174 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultHost = "https://github.com/"
+
+	grantTypeDeviceCode = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// slowDownIncrement is added to the polling interval on slow_down, as
+	// required by RFC 8628.
+	slowDownIncrement = 5 * time.Second
+)
+
+var (
+	ErrAccessDenied = errors.New("access denied by the user")
+	ErrExpiredToken = errors.New("device code expired")
+)
+
+// DeviceCode is the response of the device authorization request.
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// Token is an OAuth access token.
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	Scope       string `json:"scope"`
+}
+
+// DeviceFlow implements the OAuth 2.0 device authorization grant of GitHub.
+//
+// GITHUB-API docs: https://docs.github.com/apps/oauth-apps/building-oauth-apps/authorizing-oauth-apps#device-flow
+type DeviceFlow struct {
+	ClientID string
+	Scopes   []string
+
+	client *http.Client
+	host   *url.URL
+	sleep  func(ctx context.Context, d time.Duration) error
+}
+
+// NewDeviceFlow creates a device flow for the OAuth app with clientID.
+func NewDeviceFlow(client *http.Client, clientID string, scopes []string) *DeviceFlow {
+	host, _ := url.Parse(defaultHost)
+	return &DeviceFlow{
+		ClientID: clientID,
+		Scopes:   scopes,
+		client:   client,
+		host:     host,
+		sleep:    sleep,
+	}
+}
+
+// RequestCode requests a device and user code.
+func (f *DeviceFlow) RequestCode(ctx context.Context) (*DeviceCode, error) {
+	const op = "oauth.DeviceFlow.RequestCode"
+
+	// do device code request
+	res := new(DeviceCode)
+	form := url.Values{
+		"client_id": {f.ClientID},
+		"scope":     {strings.Join(f.Scopes, " ")},
+	}
+	if err := f.post(ctx, "login/device/code", form, res); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if res.DeviceCode == "" {
+		return nil, fmt.Errorf("%s: empty device code", op)
+	}
+	return res, nil
+}
+
+// PollToken polls for the access token until the user has entered the user
+// code of code, denied the request or the code expired.
+func (f *DeviceFlow) PollToken(ctx context.Context, code *DeviceCode) (*Token, error) {
+	const op = "oauth.DeviceFlow.PollToken"
+
+	interval := time.Duration(code.Interval) * time.Second
+	if interval <= 0 {
+		interval = slowDownIncrement
+	}
+	form := url.Values{
+		"client_id":   {f.ClientID},
+		"device_code": {code.DeviceCode},
+		"grant_type":  {grantTypeDeviceCode},
+	}
+
+	for {
+		if err := f.sleep(ctx, interval); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		// do access token request
+		var res struct {
+			Token
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+			Interval    int    `json:"interval"`
+		}
+		if err := f.post(ctx, "login/oauth/access_token", form, &res); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		switch res.Error {
+		case "":
+			return &res.Token, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += slowDownIncrement
+			if res.Interval > 0 {
+				interval = time.Duration(res.Interval) * time.Second
+			}
+		case "expired_token":
+			return nil, fmt.Errorf("%s: %w", op, ErrExpiredToken)
+		case "access_denied":
+			return nil, fmt.Errorf("%s: %w", op, ErrAccessDenied)
+		default:
+			return nil, fmt.Errorf("%s: %s: %s", op, res.Error, res.Description)
+		}
+	}
+}
+
+// post sends form to path and decodes the JSON response into res.
+func (f *DeviceFlow) post(ctx context.Context, path string, form url.Values, res any) error {
+	u, err := f.host.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	// prepare form request
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	// do form request
+	resp, err := f.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("invalid status code: %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
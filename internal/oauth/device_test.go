@@ -0,0 +1,79 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func setupDeviceFlow(handler http.Handler) (*DeviceFlow, *[]time.Duration) {
+	server := httptest.NewServer(handler)
+	f := NewDeviceFlow(server.Client(), "client", []string{"repo", "read:org"})
+	f.host, _ = url.Parse(server.URL + "/")
+
+	var slept []time.Duration
+	f.sleep = func(ctx context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+	return f, &slept
+}
+
+func TestDeviceFlow_RequestCode(t *testing.T) {
+	f, _ := setupDeviceFlow(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/login/device/code" || r.FormValue("client_id") != "client" || r.FormValue("scope") != "repo read:org" {
+			t.Errorf("unexpected request %s %v", r.URL.Path, r.Form)
+		}
+		fmt.Fprint(w, `{"device_code": "dc", "user_code": "ABCD-1234", "verification_uri": "https://github.com/login/device", "expires_in": 900, "interval": 5}`)
+	}))
+
+	got, err := f.RequestCode(context.Background())
+	if err != nil {
+		t.Fatalf("RequestCode() error = %v", err)
+	}
+	if got.UserCode != "ABCD-1234" || got.Interval != 5 {
+		t.Errorf("RequestCode() got = %+v, want user code ABCD-1234 and interval 5", got)
+	}
+}
+
+func TestDeviceFlow_PollToken(t *testing.T) {
+	responses := []string{
+		`{"error": "authorization_pending"}`,
+		`{"error": "slow_down", "interval": 10}`,
+		`{"access_token": "gho_x", "token_type": "bearer", "scope": "repo"}`,
+	}
+	f, slept := setupDeviceFlow(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("grant_type") != grantTypeDeviceCode || r.FormValue("device_code") != "dc" {
+			t.Errorf("unexpected form %v", r.Form)
+		}
+		fmt.Fprint(w, responses[0])
+		responses = responses[1:]
+	}))
+
+	got, err := f.PollToken(context.Background(), &DeviceCode{DeviceCode: "dc", Interval: 5})
+	if err != nil {
+		t.Fatalf("PollToken() error = %v", err)
+	}
+	if got.AccessToken != "gho_x" {
+		t.Errorf("PollToken() got = %v, want %v", got.AccessToken, "gho_x")
+	}
+	want := []time.Duration{5 * time.Second, 5 * time.Second, 10 * time.Second}
+	if fmt.Sprint(*slept) != fmt.Sprint(want) {
+		t.Errorf("PollToken() intervals = %v, want %v", *slept, want)
+	}
+}
+
+func TestDeviceFlow_PollToken_Denied(t *testing.T) {
+	f, _ := setupDeviceFlow(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"error": "access_denied"}`)
+	}))
+
+	if _, err := f.PollToken(context.Background(), &DeviceCode{DeviceCode: "dc"}); !errors.Is(err, ErrAccessDenied) {
+		t.Errorf("PollToken() error = %v, want %v", err, ErrAccessDenied)
+	}
+}